@@ -0,0 +1,373 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gonutz/prototype/internal/builtinfont"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+func usage() {
+	fmt.Fprint(os.Stderr, `drawfont rasterizes a TrueType or OpenType font into a bitmap font for
+github.com/gonutz/prototype/draw.
+
+It writes two files: the atlas image <out>.png with all glyphs in white on a
+transparent background and the glyph metrics <out>.json. Load them with
+draw.LoadBitmapFont("<out>.json").
+
+Usage:
+
+  drawfont [flags] font.ttf
+
+The flags are:
+`)
+	flag.PrintDefaults()
+}
+
+func main() {
+	var (
+		size      = flag.Float64("size", 32, "font size in pixels")
+		margin    = flag.Int("margin", 2, "empty pixels around each glyph")
+		out       = flag.String("out", "font", "output path without extension")
+		chars     = flag.String("chars", "", "characters to include, e.g. \"äöüß\"")
+		charsFile = flag.String("chars-file", "", "text file whose characters to include,\ne.g. all texts of a game")
+		ranges    = flag.String("ranges", "", "rune ranges to include, e.g. \"32-126,0x400-0x4FF\"")
+		grid      = flag.Bool("grid", false, "place the glyphs in a grid of 16 equal cells per row,\n"+
+			"in the order of the runes, like the built-in font")
+	)
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		usage()
+		os.Exit(2)
+	}
+
+	runes, err := runeSet(*chars, *charsFile, *ranges)
+	check(err)
+	check(run(flag.Arg(0), float32(*size), *margin, runes, *grid, *out))
+}
+
+func check(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+// runeSet collects the runes from all flags, in the order that they are given
+// and without duplicates. Without any runes given, it returns the runes of the
+// built-in font.
+func runeSet(chars, charsFile, ranges string) ([]rune, error) {
+	var runes []rune
+	seen := make(map[rune]bool)
+	add := func(r rune) {
+		if !seen[r] && r != '\n' && r != '\r' && r != '\t' {
+			seen[r] = true
+			runes = append(runes, r)
+		}
+	}
+
+	for _, r := range chars {
+		add(r)
+	}
+
+	if charsFile != "" {
+		data, err := os.ReadFile(charsFile)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range string(data) {
+			add(r)
+		}
+	}
+
+	if ranges != "" {
+		for _, part := range strings.Split(ranges, ",") {
+			from, to, err := parseRange(strings.TrimSpace(part))
+			if err != nil {
+				return nil, err
+			}
+			for r := from; r <= to; r++ {
+				add(r)
+			}
+		}
+	}
+
+	if len(runes) == 0 {
+		return builtinfont.Runes, nil
+	}
+	return runes, nil
+}
+
+// parseRange parses "first-last" or a single rune, in decimal or hexadecimal
+// with a 0x prefix.
+func parseRange(s string) (from, to rune, err error) {
+	first, last := s, s
+	if i := strings.Index(s, "-"); i != -1 {
+		first, last = s[:i], s[i+1:]
+	}
+	a, err := strconv.ParseInt(strings.TrimSpace(first), 0, 32)
+	if err != nil {
+		return 0, 0, errors.New("invalid rune range: " + s)
+	}
+	b, err := strconv.ParseInt(strings.TrimSpace(last), 0, 32)
+	if err != nil || b < a {
+		return 0, 0, errors.New("invalid rune range: " + s)
+	}
+	return rune(a), rune(b), nil
+}
+
+// fontFile is the glyph metrics file. It must match the format that
+// draw.LoadBitmapFont reads.
+type fontFile struct {
+	Image      string      `json:"image"`
+	Size       float32     `json:"size"`
+	Ascent     int         `json:"ascent"`
+	LineHeight int         `json:"lineHeight"`
+	Glyphs     []fileGlyph `json:"glyphs"`
+}
+
+type fileGlyph struct {
+	Rune    rune    `json:"rune"`
+	X       int     `json:"x"`
+	Y       int     `json:"y"`
+	Width   int     `json:"width"`
+	Height  int     `json:"height"`
+	OffsetX int     `json:"offsetX"`
+	OffsetY int     `json:"offsetY"`
+	Advance float32 `json:"advance"`
+}
+
+// glyph is a rasterized rune. Its mask is drawn into the atlas at x,y.
+type glyph struct {
+	fileGlyph
+	mask *image.Alpha
+}
+
+func run(fontPath string, size float32, margin int, runes []rune, grid bool, out string) error {
+	if size <= 0 {
+		return errors.New("font size must be positive")
+	}
+	if margin < 0 {
+		return errors.New("margin must not be negative")
+	}
+
+	data, err := os.ReadFile(fontPath)
+	if err != nil {
+		return err
+	}
+	parsed, err := opentype.Parse(data)
+	if err != nil {
+		return errors.New("parsing font: " + err.Error())
+	}
+	atlas, file, err := rasterize(parsed, size, margin, runes, grid)
+	if err != nil {
+		return err
+	}
+	file.Image = filepath.Base(out) + ".png"
+
+	if err := savePNG(out+".png", atlas); err != nil {
+		return err
+	}
+	metricsJSON, err := json.MarshalIndent(file, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(out+".json", metricsJSON, 0666)
+}
+
+// rasterize draws the runes into an atlas image and returns it with the glyph
+// metrics. The metrics' image path is left empty.
+func rasterize(parsed *opentype.Font, size float32, margin int, runes []rune, grid bool) (*image.NRGBA, fontFile, error) {
+	face, err := opentype.NewFace(parsed, &opentype.FaceOptions{
+		Size:    float64(size),
+		DPI:     72, // at 72 DPI, one point is one pixel
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return nil, fontFile{}, errors.New("creating font face: " + err.Error())
+	}
+	defer face.Close()
+
+	var buf sfnt.Buffer
+	var glyphs []glyph
+	for _, r := range runes {
+		index, err := parsed.GlyphIndex(&buf, r)
+		if err != nil || index == 0 {
+			if !grid || r != 0 {
+				fmt.Fprintf(os.Stderr, "warning: the font has no glyph for %q (%U)\n", r, r)
+			}
+			if grid {
+				// Keep the cell empty to not shift the following runes.
+				glyphs = append(glyphs, glyph{fileGlyph: fileGlyph{Rune: r}})
+			}
+			continue
+		}
+		dr, mask, maskp, advance, _ := face.Glyph(fixed.Point26_6{}, r)
+		// The face reuses its mask for every glyph so we keep a copy.
+		alpha := image.NewAlpha(image.Rect(0, 0, dr.Dx(), dr.Dy()))
+		draw.Draw(alpha, alpha.Bounds(), mask, maskp, draw.Src)
+		glyphs = append(glyphs, glyph{
+			fileGlyph: fileGlyph{
+				Rune:    r,
+				Width:   dr.Dx(),
+				Height:  dr.Dy(),
+				OffsetX: dr.Min.X,
+				OffsetY: dr.Min.Y,
+				Advance: float32(advance) / 64,
+			},
+			mask: alpha,
+		})
+	}
+	if len(glyphs) == 0 {
+		return nil, fontFile{}, errors.New("the font has none of the runes")
+	}
+
+	var width, height int
+	if grid {
+		width, height = placeInGrid(glyphs, margin)
+	} else {
+		width, height = pack(glyphs, margin)
+	}
+
+	atlas := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for _, g := range glyphs {
+		for y := 0; y < g.Height; y++ {
+			for x := 0; x < g.Width; x++ {
+				atlas.Pix[atlas.PixOffset(g.X+x, g.Y+y)+3] = g.mask.AlphaAt(x, y).A
+			}
+		}
+	}
+	// The glyphs are white, their shape is in the alpha channel.
+	for i := 0; i < len(atlas.Pix); i += 4 {
+		atlas.Pix[i+0] = 255
+		atlas.Pix[i+1] = 255
+		atlas.Pix[i+2] = 255
+	}
+
+	metrics := face.Metrics()
+	file := fontFile{
+		Size:       size,
+		Ascent:     metrics.Ascent.Ceil(),
+		LineHeight: metrics.Height.Ceil(),
+	}
+	for _, g := range glyphs {
+		if g.Rune != 0 {
+			file.Glyphs = append(file.Glyphs, g.fileGlyph)
+		}
+	}
+	sort.Slice(file.Glyphs, func(i, j int) bool {
+		return file.Glyphs[i].Rune < file.Glyphs[j].Rune
+	})
+	return atlas, file, nil
+}
+
+// placeInGrid puts the glyphs into equal cells, 16 per row, in their current
+// order. Each glyph is centered horizontally in its cell and all glyphs share
+// the same baseline. It returns the size of the atlas.
+func placeInGrid(glyphs []glyph, margin int) (width, height int) {
+	const cellsPerRow = 16
+
+	maxWidth, maxAscent, maxDescent := 0, 0, 0
+	for _, g := range glyphs {
+		if g.mask == nil {
+			continue
+		}
+		if g.Width > maxWidth {
+			maxWidth = g.Width
+		}
+		if -g.OffsetY > maxAscent {
+			maxAscent = -g.OffsetY
+		}
+		if g.OffsetY+g.Height > maxDescent {
+			maxDescent = g.OffsetY + g.Height
+		}
+	}
+
+	cellW := maxWidth + 2*margin
+	cellH := maxAscent + maxDescent + 2*margin
+	baseline := maxAscent + margin
+	rows := (len(glyphs) + cellsPerRow - 1) / cellsPerRow
+	if rows < cellsPerRow {
+		// The built-in font always has 16 rows.
+		rows = cellsPerRow
+	}
+
+	for i := range glyphs {
+		g := &glyphs[i]
+		cellX := (i % cellsPerRow) * cellW
+		cellY := (i / cellsPerRow) * cellH
+		g.X = cellX + (cellW-g.Width)/2
+		g.Y = cellY + baseline + g.OffsetY
+	}
+
+	return cellsPerRow * cellW, rows * cellH
+}
+
+// pack places the glyphs in rows, tallest first, into an atlas that is about
+// square. It returns the size of the atlas.
+func pack(glyphs []glyph, margin int) (width, height int) {
+	area, maxWidth := 0, 0
+	for _, g := range glyphs {
+		area += (g.Width + margin) * (g.Height + margin)
+		if g.Width > maxWidth {
+			maxWidth = g.Width
+		}
+	}
+	width = 64
+	for width*width < area || width < maxWidth+2*margin {
+		width *= 2
+	}
+
+	order := make([]int, len(glyphs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return glyphs[order[i]].Height > glyphs[order[j]].Height
+	})
+
+	x, y, rowHeight := margin, margin, 0
+	for _, i := range order {
+		g := &glyphs[i]
+		if g.Width == 0 || g.Height == 0 {
+			continue
+		}
+		if x+g.Width+margin > width {
+			x = margin
+			y += rowHeight + margin
+			rowHeight = 0
+		}
+		g.X, g.Y = x, y
+		x += g.Width + margin
+		if g.Height > rowHeight {
+			rowHeight = g.Height
+		}
+	}
+
+	return width, y + rowHeight + margin
+}
+
+func savePNG(path string, img image.Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return png.Encode(f, img)
+}
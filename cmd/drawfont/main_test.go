@@ -0,0 +1,50 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"testing"
+
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/opentype"
+)
+
+func TestEveryGlyphIsDrawnWithItsOwnShape(t *testing.T) {
+	parsed, err := opentype.Parse(gomono.TTF)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, grid := range []bool{false, true} {
+		atlas, file, err := rasterize(parsed, 20, 2, []rune{'I', 'W'}, grid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(file.Glyphs) != 2 {
+			t.Fatalf("want 2 glyphs but have %v", file.Glyphs)
+		}
+		for _, g := range file.Glyphs {
+			// Rasterizing the rune alone gives the shape that it must have.
+			alone, aloneFile, err := rasterize(parsed, 20, 2, []rune{g.Rune}, grid)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(glyphAlpha(atlas, g), glyphAlpha(alone, aloneFile.Glyphs[0])) {
+				t.Errorf("grid %v: %q differs from %q rasterized alone", grid, g.Rune, g.Rune)
+			}
+		}
+		if bytes.Equal(glyphAlpha(atlas, file.Glyphs[0]), glyphAlpha(atlas, file.Glyphs[1])) {
+			t.Errorf("grid %v: I and W have the same shape", grid)
+		}
+	}
+}
+
+func glyphAlpha(atlas *image.NRGBA, g fileGlyph) []byte {
+	var alpha []byte
+	for y := g.Y; y < g.Y+g.Height; y++ {
+		for x := g.X; x < g.X+g.Width; x++ {
+			alpha = append(alpha, atlas.Pix[atlas.PixOffset(x, y)+3])
+		}
+	}
+	return alpha
+}
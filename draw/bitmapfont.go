@@ -0,0 +1,106 @@
+package draw
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"image"
+	"image/draw"
+	_ "image/png" // drawfont writes PNG images.
+	"path"
+	"path/filepath"
+	"strconv"
+)
+
+// bitmapFontFile is the glyph metrics file written by the drawfont command,
+// see github.com/gonutz/prototype/cmd/drawfont.
+type bitmapFontFile struct {
+	Image      string            `json:"image"`
+	Size       float32           `json:"size"`
+	Ascent     int               `json:"ascent"`
+	LineHeight int               `json:"lineHeight"`
+	Glyphs     []bitmapFontGlyph `json:"glyphs"`
+}
+
+type bitmapFontGlyph struct {
+	Rune    rune    `json:"rune"`
+	X       int     `json:"x"`
+	Y       int     `json:"y"`
+	Width   int     `json:"width"`
+	Height  int     `json:"height"`
+	OffsetX int     `json:"offsetX"`
+	OffsetY int     `json:"offsetY"`
+	Advance float32 `json:"advance"`
+}
+
+// LoadBitmapFont reads a bitmap font made with the drawfont command through
+// ReadFile. The path is that of the .json glyph metrics file, the atlas image
+// is expected next to it. Use the font with Window.SetFont just like a font
+// from LoadFont. On WASM, call it before RunWindow or from a goroutine.
+//
+// A bitmap font only has the characters that it was made with. Others are drawn
+// as a question mark unless you add a fallback with Font.LoadFallback.
+func LoadBitmapFont(metricsPath string) (*Font, error) {
+	metrics, err := ReadFile(metricsPath)
+	if err != nil {
+		return nil, err
+	}
+	return parseBitmapFont(metrics, func(imagePath string) ([]byte, error) {
+		dir := path.Dir(filepath.ToSlash(metricsPath))
+		return ReadFile(path.Join(dir, imagePath))
+	})
+}
+
+// parseBitmapFont creates a Font from the glyph metrics. It reads the atlas
+// image file named in the metrics with readImage.
+func parseBitmapFont(
+	metrics []byte,
+	readImage func(path string) ([]byte, error),
+) (*Font, error) {
+	var file bitmapFontFile
+	if err := json.Unmarshal(metrics, &file); err != nil {
+		return nil, errors.New("parsing bitmap font: " + err.Error())
+	}
+	if file.LineHeight <= 0 {
+		return nil, errors.New("bitmap font has no line height")
+	}
+
+	data, err := readImage(file.Image)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.New("decoding bitmap font image: " + err.Error())
+	}
+	atlas := image.NewNRGBA(image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy()))
+	draw.Draw(atlas, atlas.Bounds(), img, img.Bounds().Min, draw.Src)
+
+	f := &Font{
+		size:       file.Size,
+		ascent:     file.Ascent,
+		lineHeight: file.LineHeight,
+		glyphs:     make(map[rune]glyph),
+		missing:    make(map[rune]bool),
+		glyphAtlas: glyphAtlas{atlas: atlas, key: newFontKey()},
+	}
+	for _, g := range file.Glyphs {
+		if g.Width > 0 && g.Height > 0 &&
+			!image.Rect(g.X, g.Y, g.X+g.Width, g.Y+g.Height).In(atlas.Rect) {
+			return nil, errors.New("bitmap font glyph " + strconv.QuoteRune(g.Rune) +
+				" is outside the image")
+		}
+		f.glyphs[g.Rune] = glyph{
+			x:       g.X,
+			y:       g.Y,
+			width:   g.Width,
+			height:  g.Height,
+			offsetX: g.OffsetX,
+			offsetY: g.OffsetY,
+			advance: g.Advance,
+		}
+	}
+	// Glyphs added later, from fallbacks, go below the existing ones.
+	f.penY = atlas.Rect.Dy()
+	return f, nil
+}
@@ -0,0 +1,90 @@
+package draw
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"testing"
+)
+
+const testBitmapFont = `{
+	"image": "font.png",
+	"size": 10,
+	"ascent": 8,
+	"lineHeight": 12,
+	"glyphs": [
+		{"rune": 32, "advance": 5},
+		{"rune": 63, "x": 0, "y": 0, "width": 4, "height": 8, "offsetY": -8, "advance": 5},
+		{"rune": 65, "x": 4, "y": 0, "width": 4, "height": 8, "offsetX": 1, "offsetY": -8, "advance": 6}
+	]
+}`
+
+func TestBitmapFontIsLoadedFromMetricsAndImage(t *testing.T) {
+	f, err := parseBitmapFont([]byte(testBitmapFont), testBitmapFontImage(8, 8))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if f.ascent != 8 || f.lineHeight != 12 {
+		t.Errorf("want ascent 8 and line height 12 but have %d and %d", f.ascent, f.lineHeight)
+	}
+	want := glyph{x: 4, width: 4, height: 8, offsetX: 1, offsetY: -8, advance: 6}
+	if g := f.glyph('A'); g != want {
+		t.Errorf("want glyph\n%+v\nbut have\n%+v", want, g)
+	}
+	if w, h := f.textSize("A A\nA", 1); w != 17 || h != 24 {
+		t.Errorf("want text size 17x24 but have %dx%d", w, h)
+	}
+	if f.glyph('x') != f.glyph('?') {
+		t.Error("a missing rune should use the question mark glyph")
+	}
+}
+
+func TestBitmapFontGlyphsMustBeInsideImage(t *testing.T) {
+	_, err := parseBitmapFont([]byte(testBitmapFont), testBitmapFontImage(6, 8))
+	if err == nil {
+		t.Error("a glyph outside the image should be an error")
+	}
+}
+
+func TestBitmapFontImageErrorIsReturned(t *testing.T) {
+	_, err := parseBitmapFont([]byte(testBitmapFont), func(string) ([]byte, error) {
+		return nil, errors.New("file not found")
+	})
+	if err == nil || err.Error() != "file not found" {
+		t.Errorf("want file not found error but have %v", err)
+	}
+}
+
+func TestFallbackGlyphsGrowNarrowBitmapFontAtlas(t *testing.T) {
+	f, err := parseBitmapFont([]byte(testBitmapFont), testBitmapFontImage(8, 8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.ParseFallback(goMonoTTF); err != nil {
+		t.Fatal(err)
+	}
+
+	g := f.glyph('W')
+	if g.width == 0 || g.y < 8 {
+		t.Errorf("the fallback glyph should be below the existing ones but is %+v", g)
+	}
+	if !image.Rect(g.x, g.y, g.x+g.width, g.y+g.height).In(f.atlas.Rect) {
+		t.Errorf("glyph %+v is outside the %v atlas", g, f.atlas.Rect)
+	}
+	if f.glyph('A').advance != 6 {
+		t.Error("the bitmap font glyphs should be kept")
+	}
+}
+
+func testBitmapFontImage(width, height int) func(string) ([]byte, error) {
+	return func(path string) ([]byte, error) {
+		if path != "font.png" {
+			return nil, errors.New("wrong image path: " + path)
+		}
+		var buf bytes.Buffer
+		err := png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, width, height)))
+		return buf.Bytes(), err
+	}
+}
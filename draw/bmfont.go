@@ -0,0 +1,414 @@
+package draw
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"image"
+	"image/draw"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// bmFont is the contents of an AngelCode BMFont file, in any of its formats.
+type bmFont struct {
+	size       int
+	lineHeight int
+	base       int
+	pages      []string
+	chars      []bmChar
+	kernings   []bmKerning
+}
+
+type bmChar struct {
+	id                  rune
+	x, y, width, height int
+	xOffset, yOffset    int
+	xAdvance            int
+	page                int
+}
+
+type bmKerning struct {
+	first, second rune
+	amount        int
+}
+
+// LoadBMFont reads a bitmap font in the AngelCode BMFont format through
+// ReadFile. The path is that of the .fnt file, which can be in the text, XML
+// or binary format. Its page images are expected next to it. Tools like
+// BMFont, Hiero or Littera create these fonts. Use the font with
+// Window.SetFont just like a font from LoadFont. On WASM, call it before
+// RunWindow or from a goroutine.
+//
+// The glyphs should be white, they are tinted with the text color. Page images
+// without transparency are treated as gray scale, where white is opaque.
+// Characters that the font does not have are drawn as a question mark unless
+// you add a fallback with Font.LoadFallback.
+func LoadBMFont(fntPath string) (*Font, error) {
+	data, err := ReadFile(fntPath)
+	if err != nil {
+		return nil, err
+	}
+	return parseBMFont(data, func(page string) ([]byte, error) {
+		dir := path.Dir(filepath.ToSlash(fntPath))
+		return ReadFile(path.Join(dir, page))
+	})
+}
+
+// parseBMFont creates a Font from the contents of a .fnt file. It reads the
+// page images named in it with readImage.
+func parseBMFont(
+	data []byte,
+	readImage func(path string) ([]byte, error),
+) (*Font, error) {
+	var file bmFont
+	var err error
+	trimmed := bytes.TrimLeft(data, "\xEF\xBB\xBF \t\r\n")
+	if bytes.HasPrefix(data, []byte("BMF")) {
+		file, err = parseBinaryBMFont(data)
+	} else if bytes.HasPrefix(trimmed, []byte("<")) {
+		file, err = parseXMLBMFont(data)
+	} else {
+		file, err = parseTextBMFont(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if file.lineHeight <= 0 {
+		return nil, errors.New("BMFont has no line height")
+	}
+
+	// All pages are stacked vertically into one atlas.
+	pages := make([]image.Image, len(file.pages))
+	pageY := make([]int, len(file.pages))
+	width, height := 0, 0
+	for i, name := range file.pages {
+		data, err := readImage(name)
+		if err != nil {
+			return nil, err
+		}
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.New("decoding BMFont page " + name + ": " + err.Error())
+		}
+		pages[i] = img
+		pageY[i] = height
+		width = maxInt(width, img.Bounds().Dx())
+		height += img.Bounds().Dy()
+	}
+	atlas := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for i, page := range pages {
+		b := page.Bounds()
+		dest := image.Rect(0, pageY[i], b.Dx(), pageY[i]+b.Dy())
+		draw.Draw(atlas, dest, page, b.Min, draw.Src)
+		if isOpaque(atlas.SubImage(dest).(*image.NRGBA)) {
+			grayToAlpha(atlas.SubImage(dest).(*image.NRGBA))
+		}
+	}
+
+	size := file.size
+	if size < 0 {
+		// A negative size means the height of the characters is matched
+		// instead of the cell height.
+		size = -size
+	}
+	if size == 0 {
+		size = file.lineHeight
+	}
+
+	f := &Font{
+		size:       float32(size),
+		ascent:     file.base,
+		lineHeight: file.lineHeight,
+		glyphs:     make(map[rune]glyph),
+		missing:    make(map[rune]bool),
+		glyphAtlas: glyphAtlas{atlas: atlas, key: newFontKey()},
+	}
+	for _, c := range file.chars {
+		if c.page < 0 || c.page >= len(pages) {
+			return nil, errors.New("BMFont character " + strconv.QuoteRune(c.id) +
+				" is on page " + strconv.Itoa(c.page) + " which does not exist")
+		}
+		b := pages[c.page].Bounds()
+		if c.width > 0 && c.height > 0 &&
+			!image.Rect(c.x, c.y, c.x+c.width, c.y+c.height).In(image.Rect(0, 0, b.Dx(), b.Dy())) {
+			return nil, errors.New("BMFont character " + strconv.QuoteRune(c.id) +
+				" is outside its page image")
+		}
+		f.glyphs[c.id] = glyph{
+			x:       c.x,
+			y:       c.y + pageY[c.page],
+			width:   c.width,
+			height:  c.height,
+			offsetX: c.xOffset,
+			offsetY: c.yOffset - file.base,
+			advance: float32(c.xAdvance),
+		}
+	}
+	if len(file.kernings) > 0 {
+		f.kerning = make(map[[2]rune]float32)
+		for _, k := range file.kernings {
+			f.kerning[[2]rune{k.first, k.second}] = float32(k.amount)
+		}
+	}
+	// Glyphs added later, from fallbacks, go below the existing ones.
+	f.penY = atlas.Rect.Dy()
+	return f, nil
+}
+
+func isOpaque(img *image.NRGBA) bool {
+	for y := img.Rect.Min.Y; y < img.Rect.Max.Y; y++ {
+		for x := img.Rect.Min.X; x < img.Rect.Max.X; x++ {
+			if img.Pix[img.PixOffset(x, y)+3] != 255 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// grayToAlpha turns the image into white with its brightness as the alpha.
+func grayToAlpha(img *image.NRGBA) {
+	for y := img.Rect.Min.Y; y < img.Rect.Max.Y; y++ {
+		for x := img.Rect.Min.X; x < img.Rect.Max.X; x++ {
+			i := img.PixOffset(x, y)
+			p := img.Pix[i : i+4]
+			gray := (int(p[0]) + int(p[1]) + int(p[2])) / 3
+			p[0], p[1], p[2], p[3] = 255, 255, 255, uint8(gray)
+		}
+	}
+}
+
+// parseTextBMFont parses the text format, which has one tag per line followed
+// by key=value pairs, e.g.
+//
+//	char id=65 x=10 y=0 width=8 height=12 xoffset=0 yoffset=2 xadvance=9 page=0
+func parseTextBMFont(data []byte) (bmFont, error) {
+	var file bmFont
+	for n, line := range strings.Split(string(data), "\n") {
+		tag, attrs, err := parseBMFontLine(line)
+		if err != nil {
+			return bmFont{}, errors.New("BMFont line " + strconv.Itoa(n+1) + ": " + err.Error())
+		}
+		num := func(key string) int {
+			v, _ := strconv.Atoi(attrs[key])
+			return v
+		}
+
+		switch tag {
+		case "info":
+			file.size = num("size")
+		case "common":
+			file.lineHeight = num("lineHeight")
+			file.base = num("base")
+		case "page":
+			id := num("id")
+			for len(file.pages) <= id {
+				file.pages = append(file.pages, "")
+			}
+			file.pages[id] = attrs["file"]
+		case "char":
+			file.chars = append(file.chars, bmChar{
+				id:       rune(num("id")),
+				x:        num("x"),
+				y:        num("y"),
+				width:    num("width"),
+				height:   num("height"),
+				xOffset:  num("xoffset"),
+				yOffset:  num("yoffset"),
+				xAdvance: num("xadvance"),
+				page:     num("page"),
+			})
+		case "kerning":
+			file.kernings = append(file.kernings, bmKerning{
+				first:  rune(num("first")),
+				second: rune(num("second")),
+				amount: num("amount"),
+			})
+		}
+	}
+	return file, nil
+}
+
+// parseBMFontLine splits a line of the text format into its tag and
+// attributes. Values may be quoted to contain spaces.
+func parseBMFontLine(line string) (tag string, attrs map[string]string, err error) {
+	line = strings.TrimSpace(line)
+	if i := strings.IndexAny(line, " \t"); i != -1 {
+		tag, line = line[:i], line[i:]
+	} else {
+		return line, nil, nil
+	}
+
+	attrs = make(map[string]string)
+	for {
+		line = strings.TrimLeft(line, " \t")
+		if line == "" {
+			return tag, attrs, nil
+		}
+		eq := strings.IndexByte(line, '=')
+		if eq == -1 {
+			return "", nil, errors.New("missing = after " + strconv.Quote(line))
+		}
+		key := line[:eq]
+		line = line[eq+1:]
+
+		var value string
+		if strings.HasPrefix(line, `"`) {
+			end := strings.IndexByte(line[1:], '"')
+			if end == -1 {
+				return "", nil, errors.New("unterminated quote for " + key)
+			}
+			value, line = line[1:1+end], line[2+end:]
+		} else {
+			end := strings.IndexAny(line, " \t")
+			if end == -1 {
+				end = len(line)
+			}
+			value, line = line[:end], line[end:]
+		}
+		attrs[key] = value
+	}
+}
+
+// parseXMLBMFont parses the XML format, which has the same tags and
+// attributes as the text format.
+func parseXMLBMFont(data []byte) (bmFont, error) {
+	var doc struct {
+		Info struct {
+			Size int `xml:"size,attr"`
+		} `xml:"info"`
+		Common struct {
+			LineHeight int `xml:"lineHeight,attr"`
+			Base       int `xml:"base,attr"`
+		} `xml:"common"`
+		Pages []struct {
+			ID   int    `xml:"id,attr"`
+			File string `xml:"file,attr"`
+		} `xml:"pages>page"`
+		Chars []struct {
+			ID       int `xml:"id,attr"`
+			X        int `xml:"x,attr"`
+			Y        int `xml:"y,attr"`
+			Width    int `xml:"width,attr"`
+			Height   int `xml:"height,attr"`
+			XOffset  int `xml:"xoffset,attr"`
+			YOffset  int `xml:"yoffset,attr"`
+			XAdvance int `xml:"xadvance,attr"`
+			Page     int `xml:"page,attr"`
+		} `xml:"chars>char"`
+		Kernings []struct {
+			First  int `xml:"first,attr"`
+			Second int `xml:"second,attr"`
+			Amount int `xml:"amount,attr"`
+		} `xml:"kernings>kerning"`
+	}
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return bmFont{}, errors.New("parsing BMFont XML: " + err.Error())
+	}
+
+	file := bmFont{
+		size:       doc.Info.Size,
+		lineHeight: doc.Common.LineHeight,
+		base:       doc.Common.Base,
+	}
+	for _, p := range doc.Pages {
+		for len(file.pages) <= p.ID {
+			file.pages = append(file.pages, "")
+		}
+		file.pages[p.ID] = p.File
+	}
+	for _, c := range doc.Chars {
+		file.chars = append(file.chars, bmChar{
+			id:       rune(c.ID),
+			x:        c.X,
+			y:        c.Y,
+			width:    c.Width,
+			height:   c.Height,
+			xOffset:  c.XOffset,
+			yOffset:  c.YOffset,
+			xAdvance: c.XAdvance,
+			page:     c.Page,
+		})
+	}
+	for _, k := range doc.Kernings {
+		file.kernings = append(file.kernings, bmKerning{
+			first:  rune(k.First),
+			second: rune(k.Second),
+			amount: k.Amount,
+		})
+	}
+	return file, nil
+}
+
+// parseBinaryBMFont parses version 3 of the binary format. It starts with
+// "BMF" and the version, followed by blocks of a type byte, a 32 bit size and
+// the block data. All numbers are little endian.
+func parseBinaryBMFont(data []byte) (bmFont, error) {
+	if len(data) < 4 || data[3] != 3 {
+		return bmFont{}, errors.New("only version 3 of the binary BMFont format is supported")
+	}
+	data = data[4:]
+	le := binary.LittleEndian
+
+	var file bmFont
+	for len(data) > 0 {
+		if len(data) < 5 {
+			return bmFont{}, errors.New("binary BMFont has a truncated block header")
+		}
+		blockType := data[0]
+		size := int(le.Uint32(data[1:]))
+		data = data[5:]
+		if size > len(data) {
+			return bmFont{}, errors.New("binary BMFont block " + strconv.Itoa(int(blockType)) +
+				" is truncated")
+		}
+		block := data[:size]
+		data = data[size:]
+
+		switch blockType {
+		case 1: // info
+			if len(block) >= 2 {
+				file.size = int(int16(le.Uint16(block)))
+			}
+		case 2: // common
+			if len(block) < 4 {
+				return bmFont{}, errors.New("binary BMFont common block is too short")
+			}
+			file.lineHeight = int(le.Uint16(block))
+			file.base = int(le.Uint16(block[2:]))
+		case 3: // pages, as zero terminated strings
+			for _, name := range bytes.Split(block, []byte{0}) {
+				if len(name) > 0 {
+					file.pages = append(file.pages, string(name))
+				}
+			}
+		case 4: // chars, 20 bytes each
+			for ; len(block) >= 20; block = block[20:] {
+				file.chars = append(file.chars, bmChar{
+					id:       rune(le.Uint32(block)),
+					x:        int(le.Uint16(block[4:])),
+					y:        int(le.Uint16(block[6:])),
+					width:    int(le.Uint16(block[8:])),
+					height:   int(le.Uint16(block[10:])),
+					xOffset:  int(int16(le.Uint16(block[12:]))),
+					yOffset:  int(int16(le.Uint16(block[14:]))),
+					xAdvance: int(int16(le.Uint16(block[16:]))),
+					page:     int(block[18]),
+				})
+			}
+		case 5: // kerning pairs, 10 bytes each
+			for ; len(block) >= 10; block = block[10:] {
+				file.kernings = append(file.kernings, bmKerning{
+					first:  rune(le.Uint32(block)),
+					second: rune(le.Uint32(block[4:])),
+					amount: int(int16(le.Uint16(block[8:]))),
+				})
+			}
+		}
+	}
+	return file, nil
+}
@@ -0,0 +1,194 @@
+package draw
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+const testTextBMFont = `info face="Test Font" size=-10 bold=0 italic=0 padding=0,0,0,0
+common lineHeight=12 base=8 scaleW=8 scaleH=8 pages=2 packed=0
+page id=0 file="page 0.png"
+page id=1 file="page 1.png"
+chars count=3
+char id=32 x=0 y=0 width=0 height=0 xoffset=0 yoffset=0 xadvance=5 page=0 chnl=15
+char id=63 x=0 y=0 width=4 height=8 xoffset=0 yoffset=0 xadvance=5 page=0 chnl=15
+char id=65 x=4 y=0 width=4 height=8 xoffset=1 yoffset=2 xadvance=6 page=1 chnl=15
+kernings count=1
+kerning first=65 second=65 amount=-2
+`
+
+const testXMLBMFont = `<?xml version="1.0"?>
+<font>
+  <info face="Test Font" size="-10"/>
+  <common lineHeight="12" base="8" scaleW="8" scaleH="8" pages="2" packed="0"/>
+  <pages>
+    <page id="0" file="page 0.png"/>
+    <page id="1" file="page 1.png"/>
+  </pages>
+  <chars count="3">
+    <char id="32" x="0" y="0" width="0" height="0" xoffset="0" yoffset="0" xadvance="5" page="0"/>
+    <char id="63" x="0" y="0" width="4" height="8" xoffset="0" yoffset="0" xadvance="5" page="0"/>
+    <char id="65" x="4" y="0" width="4" height="8" xoffset="1" yoffset="2" xadvance="6" page="1"/>
+  </chars>
+  <kernings count="1">
+    <kerning first="65" second="65" amount="-2"/>
+  </kernings>
+</font>`
+
+func testBinaryBMFont() []byte {
+	var buf bytes.Buffer
+	le := binary.LittleEndian
+	block := func(blockType byte, data ...interface{}) {
+		var b bytes.Buffer
+		for _, d := range data {
+			binary.Write(&b, le, d)
+		}
+		buf.WriteByte(blockType)
+		binary.Write(&buf, le, uint32(b.Len()))
+		buf.Write(b.Bytes())
+	}
+	char := func(id uint32, x, y, w, h uint16, xOff, yOff, xAdv int16, page uint8) []interface{} {
+		return []interface{}{id, x, y, w, h, xOff, yOff, xAdv, page, uint8(15)}
+	}
+
+	buf.WriteString("BMF\x03")
+	block(1, int16(-10), uint8(0), uint8(0), uint16(100), uint8(1),
+		[4]uint8{}, [2]uint8{}, uint8(0), []byte("Test Font\x00"))
+	block(2, uint16(12), uint16(8), uint16(8), uint16(8), uint16(2),
+		uint8(0), [4]uint8{})
+	block(3, []byte("page 0.png\x00page 1.png\x00"))
+	var chars []interface{}
+	chars = append(chars, char(32, 0, 0, 0, 0, 0, 0, 5, 0)...)
+	chars = append(chars, char(63, 0, 0, 4, 8, 0, 0, 5, 0)...)
+	chars = append(chars, char(65, 4, 0, 4, 8, 1, 2, 6, 1)...)
+	block(4, chars...)
+	block(5, uint32(65), uint32(65), int16(-2))
+	return buf.Bytes()
+}
+
+func TestBMFontFormatsAreLoadedTheSame(t *testing.T) {
+	formats := []struct {
+		name string
+		data []byte
+	}{
+		{"text", []byte(testTextBMFont)},
+		{"XML", []byte(testXMLBMFont)},
+		{"binary", testBinaryBMFont()},
+	}
+	for _, format := range formats {
+		f, err := parseBMFont(format.data, testBMFontPages)
+		if err != nil {
+			t.Errorf("%s: %v", format.name, err)
+			continue
+		}
+
+		if f.size != 10 || f.ascent != 8 || f.lineHeight != 12 {
+			t.Errorf("%s: want size 10, ascent 8 and line height 12 but have %v, %d and %d",
+				format.name, f.size, f.ascent, f.lineHeight)
+		}
+		// The second page is below the first one in the atlas.
+		want := glyph{x: 4, y: 8, width: 4, height: 8, offsetX: 1, offsetY: -6, advance: 6}
+		if g := f.glyph('A'); g != want {
+			t.Errorf("%s: want glyph\n%+v\nbut have\n%+v", format.name, want, g)
+		}
+		if f.glyph('x') != f.glyph('?') {
+			t.Errorf("%s: a missing rune should use the question mark glyph", format.name)
+		}
+		// AA is kerned closer together, A A is not.
+		if w, h := f.textSize("AA\nA A", 1); w != 17 || h != 24 {
+			t.Errorf("%s: want text size 17x24 but have %dx%d", format.name, w, h)
+		}
+		if w, _ := f.textSize("AAA", 1); w != 14 {
+			t.Errorf("%s: want kerned width 14 but have %d", format.name, w)
+		}
+	}
+}
+
+func TestBMFontLayoutAppliesKerning(t *testing.T) {
+	f, err := parseBMFont([]byte(testTextBMFont), testBMFontPages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var xs []float32
+	f.layout("AA", 0, 0, 2, func(g glyph, x, y, w, h float32) {
+		xs = append(xs, x)
+	})
+	// The glyph is offset by 1, the second one comes after an advance of 6
+	// minus 2 for the kerning, all scaled by 2.
+	if len(xs) != 2 || xs[0] != 2 || xs[1] != 10 {
+		t.Errorf("want glyphs at x 2 and 10 but have %v", xs)
+	}
+}
+
+func TestOpaqueBMFontPagesAreGrayScale(t *testing.T) {
+	f, err := parseBMFont([]byte(testTextBMFont), testBMFontPages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Page 1 is a gray image without alpha, black at 0,0 and white at 4,0.
+	black := f.atlas.NRGBAAt(0, 8)
+	white := f.atlas.NRGBAAt(4, 8)
+	if black != (color.NRGBA{255, 255, 255, 0}) || white != (color.NRGBA{255, 255, 255, 255}) {
+		t.Errorf("want transparent and opaque white but have %v and %v", black, white)
+	}
+	// Page 0 has transparency and is kept as is.
+	if c := f.atlas.NRGBAAt(0, 0); c != (color.NRGBA{255, 0, 0, 128}) {
+		t.Errorf("want the page's color but have %v", c)
+	}
+}
+
+func TestBMFontCharactersMustBeInsideTheirPage(t *testing.T) {
+	_, err := parseBMFont([]byte(testTextBMFont), func(path string) ([]byte, error) {
+		return encodeTestPNG(image.NewNRGBA(image.Rect(0, 0, 6, 8)))
+	})
+	if err == nil {
+		t.Error("a character outside its page should be an error")
+	}
+}
+
+func TestBMFontPageErrorIsReturned(t *testing.T) {
+	_, err := parseBMFont([]byte(testTextBMFont), func(string) ([]byte, error) {
+		return nil, errors.New("file not found")
+	})
+	if err == nil || err.Error() != "file not found" {
+		t.Errorf("want file not found error but have %v", err)
+	}
+}
+
+func TestBMFontTextLinesMayHaveQuotedSpaces(t *testing.T) {
+	tag, attrs, err := parseBMFontLine(`page id=0  file="my page.png"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag != "page" || attrs["id"] != "0" || attrs["file"] != "my page.png" {
+		t.Errorf("wrong tag %q and attributes %v", tag, attrs)
+	}
+	if _, _, err := parseBMFontLine(`page file="unterminated`); err == nil {
+		t.Error("an unterminated quote should be an error")
+	}
+}
+
+func testBMFontPages(path string) ([]byte, error) {
+	switch path {
+	case "page 0.png":
+		img := image.NewNRGBA(image.Rect(0, 0, 8, 8))
+		img.SetNRGBA(0, 0, color.NRGBA{255, 0, 0, 128})
+		return encodeTestPNG(img)
+	case "page 1.png":
+		img := image.NewGray(image.Rect(0, 0, 8, 8))
+		img.SetGray(4, 0, color.Gray{255})
+		return encodeTestPNG(img)
+	}
+	return nil, errors.New("wrong page path: " + path)
+}
+
+func encodeTestPNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	err := png.Encode(&buf, img)
+	return buf.Bytes(), err
+}
@@ -0,0 +1,57 @@
+package draw
+
+import "time"
+
+// fixedStep decides how many updates to run per animation frame in the
+// browser. The browser calls us once per monitor refresh which might be e.g.
+// 144 Hz while the game wants to update at 60 Hz. We accumulate the time that
+// passed and run an update for every full frame interval in it. This way
+// update is called at the same rate as on desktop.
+type fixedStep struct {
+	started     bool
+	last        time.Duration
+	accumulated time.Duration
+}
+
+const (
+	// fixedStepTolerance lets an update run this much early. Animation frame
+	// time stamps jitter a little and without the tolerance, a 60 Hz monitor
+	// would sometimes get no update in one frame and two in the next.
+	fixedStepTolerance = 2 * time.Millisecond
+
+	// maxUpdatesPerFrame limits how many updates we run to catch up, e.g.
+	// after the browser tab was in the background. Frames beyond that are
+	// skipped.
+	maxUpdatesPerFrame = 4
+)
+
+// frame is called with the time stamp of each animation frame and returns
+// the number of updates to run in it. An interval of 0 means the frame rate
+// is unlimited, in which case every frame gets one update.
+func (f *fixedStep) frame(now, interval time.Duration) int {
+	if !f.started {
+		// Always update in the first frame, there is nothing to show yet.
+		f.started = true
+		f.accumulated = interval
+	} else {
+		f.accumulated += now - f.last
+	}
+	f.last = now
+
+	if interval <= 0 {
+		f.accumulated = 0
+		return 1
+	}
+
+	updates := 0
+	for f.accumulated >= interval-fixedStepTolerance {
+		if updates == maxUpdatesPerFrame {
+			// We are too far behind, skip the missed frames.
+			f.accumulated = 0
+			break
+		}
+		f.accumulated -= interval
+		updates++
+	}
+	return updates
+}
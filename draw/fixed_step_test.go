@@ -0,0 +1,82 @@
+package draw
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestFixedStepUpdatesAt60HzOnAnyMonitor(t *testing.T) {
+	for _, refresh := range []float64{30, 50, 60, 75, 120, 144, 165, 240} {
+		updates, maxPerFrame := simulateAnimationFrames(refresh, 10*time.Second, 0)
+		// 10 seconds at 60 Hz are 600 updates, plus the first frame.
+		if updates < 599 || updates > 601 {
+			t.Errorf("%v Hz: want 600 updates but have %d", refresh, updates)
+		}
+		want := 1
+		if refresh < 60 {
+			want = 2
+		}
+		if maxPerFrame != want {
+			t.Errorf("%v Hz: want at most %d updates per frame but have %d",
+				refresh, want, maxPerFrame)
+		}
+	}
+}
+
+func TestFixedStepToleratesJitter(t *testing.T) {
+	// A 60 Hz monitor should give us exactly one update per frame even if the
+	// time stamps are off by up to a millisecond.
+	updates, maxPerFrame := simulateAnimationFrames(60, 10*time.Second, time.Millisecond)
+	if updates < 599 || updates > 601 {
+		t.Errorf("want 600 updates but have %d", updates)
+	}
+	if maxPerFrame != 1 {
+		t.Errorf("want 1 update per frame but have up to %d", maxPerFrame)
+	}
+}
+
+func TestFixedStepSkipsFramesAfterLongPause(t *testing.T) {
+	var f fixedStep
+	interval := time.Second / 60
+	f.frame(0, interval)
+	// The tab was in the background for 5 seconds.
+	if n := f.frame(5*time.Second, interval); n != maxUpdatesPerFrame {
+		t.Errorf("want %d catch-up updates but have %d", maxUpdatesPerFrame, n)
+	}
+	// After that we continue normally.
+	if n := f.frame(5*time.Second+interval, interval); n != 1 {
+		t.Errorf("want 1 update after the pause but have %d", n)
+	}
+}
+
+func TestFixedStepUpdatesEveryFrameIfUnlimited(t *testing.T) {
+	var f fixedStep
+	for i := 0; i < 10; i++ {
+		if n := f.frame(time.Duration(i)*time.Millisecond, 0); n != 1 {
+			t.Fatalf("frame %d: want 1 update but have %d", i, n)
+		}
+	}
+}
+
+// simulateAnimationFrames calls fixedStep.frame like requestAnimationFrame
+// would on a monitor with the given refresh rate. Each time stamp is randomly
+// offset by up to jitter.
+func simulateAnimationFrames(refresh float64, duration, jitter time.Duration) (updates, maxPerFrame int) {
+	r := rand.New(rand.NewSource(0))
+	var f fixedStep
+	interval := time.Second / 60
+	frameTime := time.Duration(float64(time.Second) / refresh)
+	for now := time.Duration(0); now <= duration; now += frameTime {
+		stamp := now
+		if jitter > 0 {
+			stamp += time.Duration(r.Int63n(int64(2*jitter))) - jitter
+		}
+		n := f.frame(stamp, interval)
+		updates += n
+		if n > maxPerFrame {
+			maxPerFrame = n
+		}
+	}
+	return
+}
@@ -2,25 +2,44 @@ package draw
 
 import (
 	"image"
+	"unicode"
 	"unsafe"
 
 	_ "embed"
+
+	"github.com/gonutz/prototype/internal/builtinfont"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/text/width"
 )
 
 //go:embed font.png
 var bitmapFontWhitePng []byte
 
+// goMonoTTF is the font that the built-in font bitmap was made from. On WASM
+// we draw text with it directly, on desktop it provides the characters that
+// are missing in the bitmap.
+//
+//go:embed Go-Mono.ttf
+var goMonoTTF []byte
+
 // Each letter in the font bitmap has a border of fontGlyphMargin around it, to
 // each side. So the total margin left + right is fontGlyphMargin * 2.
 const fontGlyphMargin = 8
 
-// fontBaseScale is the scale factor to use for the regular text size used by
-// draw.Window.DrawText.
-const fontBaseScale = 1.0 / 8
+// builtinFontSize is the size in pixels of the built-in font at scale 1. The
+// built-in font is Go-Mono. In the browser it is drawn directly, on desktop the
+// font bitmap that was made from it is drawn.
+const builtinFontSize = 13.5
+
+// builtinLineHeight is the height of a line of the built-in font at scale 1.
+const builtinLineHeight = builtinFontSize * 1.24
 
-// fontKerningFactor is an extra scale factor for the width of each character.
-// This makes the desktop font the same size as the WASM font.
-const fontKerningFactor = 0.97
+// builtinFallbackResolution is how many times larger than the regular text
+// size the characters that are not in the font bitmap are rasterized. This
+// keeps them sharp when the text is scaled up.
+const builtinFallbackResolution = 4
 
 // runeToFont maps a unicode rune to the index of the respective glyph in the
 // font bitmap. The bitmap contains only a subset of all existing runes, if r is
@@ -32,91 +51,246 @@ func runeToFont(r rune) rune {
 	return fontMap[r]
 }
 
-var fontMap = map[rune]rune{
-	'☺': 1,
-	'☻': 2,
-	'♥': 3,
-	'♦': 4,
-	'♣': 5,
-	'♠': 6,
-	'•': 7,
-	'◘': 8,
-	'○': 9,
-	'◙': 10,
-	'♂': 11,
-	'♀': 12,
-	'♪': 13,
-	'♫': 14,
-	'☼': 15,
-	'►': 16,
-	'◄': 17,
-	'↕': 18,
-	'‼': 19,
-	'¶': 20,
-	'§': 21,
-	'▬': 22,
-	'↨': 23,
-	'↑': 24,
-	'↓': 25,
-	'→': 26,
-	'←': 27,
-	'∟': 28,
-	'↔': 29,
-	'▲': 30,
-	'▼': 31,
-	'â': 128,
-	'á': 129,
-	'à': 130,
-	'ê': 131,
-	'é': 132,
-	'è': 133,
-	'î': 134,
-	'í': 135,
-	'ì': 136,
-	'ô': 137,
-	'ó': 138,
-	'ò': 139,
-	'û': 140,
-	'ú': 141,
-	'ù': 142,
-	'Â': 143,
-	'Á': 144,
-	'À': 145,
-	'Ê': 146,
-	'É': 147,
-	'È': 148,
-	'Î': 149,
-	'Í': 150,
-	'Ì': 151,
-	'Ô': 152,
-	'Ó': 153,
-	'Ò': 154,
-	'Û': 155,
-	'Ú': 156,
-	'Ù': 157,
-	'ä': 158,
-	'ë': 159,
-	'ï': 160,
-	'ö': 161,
-	'ü': 162,
-	'Ä': 163,
-	'Ë': 164,
-	'Ï': 165,
-	'Ö': 166,
-	'Ü': 167,
-	'å': 168,
-	'ů': 169,
-	'Å': 170,
-	'Ů': 171,
-	'ç': 172,
-	'Ç': 173,
-	'ß': 174,
-	'²': 175,
-	'³': 176,
-	'´': 177,
-	'°': 178,
-	'æ': 179,
-	'Æ': 180,
+// bitmapHasRune reports whether r is in the font bitmap. All other runes are
+// rasterized from the built-in fallback font.
+func bitmapHasRune(r rune) bool {
+	if 32 <= r && r <= 127 {
+		return true
+	}
+	_, ok := fontMap[r]
+	return ok
+}
+
+// newBuiltinFallback creates the font for the characters that are not in the
+// font bitmap. Returns nil if the font cannot be created.
+func newBuiltinFallback() *Font {
+	lineHeight := float32(builtinLineHeight * builtinFallbackResolution)
+	f, err := ParseFont(goMonoTTF, lineHeight)
+	if err != nil {
+		return nil
+	}
+	// The font size is not the line height, scale it so they match.
+	f, err = ParseFont(goMonoTTF, lineHeight*lineHeight/float32(f.lineHeight))
+	if err != nil {
+		return nil
+	}
+	return f
+}
+
+// goMonoMetrics measures text in the built-in font. They are the same on all
+// platforms, so text has the same size everywhere. The advances are not hinted,
+// just like in the browser.
+type goMonoMetrics struct {
+	font *sfnt.Font
+	buf  sfnt.Buffer
+	// ppem makes the font return its measurements in font units.
+	ppem fixed.Int26_6
+	// advances are in pixels at scale 1, or -1 for runes that Go-Mono does
+	// not have.
+	advances map[rune]float32
+}
+
+var goMono *goMonoMetrics
+
+func builtinMetrics() *goMonoMetrics {
+	if goMono == nil {
+		f, err := sfnt.Parse(goMonoTTF)
+		if err != nil {
+			panic("parsing the embedded Go-Mono font: " + err.Error())
+		}
+		goMono = &goMonoMetrics{
+			font:     f,
+			ppem:     fixed.I(int(f.UnitsPerEm())),
+			advances: make(map[rune]float32),
+		}
+	}
+	return goMono
+}
+
+// toPixels converts a length in font units to pixels at scale 1.
+func (m *goMonoMetrics) toPixels(x fixed.Int26_6) float32 {
+	return float32(x) / 64 / float32(m.font.UnitsPerEm()) * builtinFontSize
+}
+
+func (m *goMonoMetrics) index(r rune) sfnt.GlyphIndex {
+	index, err := m.font.GlyphIndex(&m.buf, r)
+	if err != nil {
+		return 0
+	}
+	return index
+}
+
+// advance returns the advance of r in Go-Mono, in pixels at scale 1, and false
+// if Go-Mono does not have r.
+func (m *goMonoMetrics) advance(r rune) (float32, bool) {
+	if a, ok := m.advances[r]; ok {
+		return a, a >= 0
+	}
+	a := float32(-1)
+	if index := m.index(r); index != 0 {
+		if adv, err := m.font.GlyphAdvance(&m.buf, index, m.ppem, font.HintingNone); err == nil {
+			a = m.toPixels(adv)
+		}
+	}
+	m.advances[r] = a
+	return a, a >= 0
+}
+
+// kern returns the kerning between a and b in pixels at scale 1.
+func (m *goMonoMetrics) kern(a, b rune) float32 {
+	ia, ib := m.index(a), m.index(b)
+	if ia == 0 || ib == 0 {
+		return 0
+	}
+	k, err := m.font.Kern(&m.buf, ia, ib, m.ppem, font.HintingNone)
+	if err != nil {
+		// Fonts without kerning return an error.
+		return 0
+	}
+	return m.toPixels(k)
+}
+
+// builtinCellWidth is the width of a character cell of the built-in font at
+// scale 1. Glyphs from the font bitmap fill one cell.
+func builtinCellWidth() float32 {
+	w, _ := builtinMetrics().advance('0')
+	return w
+}
+
+// builtinAdvance returns the advance of r in the built-in font, in pixels at
+// scale 1. Runes that are neither in the font bitmap nor in Go-Mono come from
+// fallback fonts, which might differ between platforms. They take up one or
+// two cells depending on their East Asian width, or none for combining marks.
+func builtinAdvance(r rune) float32 {
+	cell := builtinCellWidth()
+	if bitmapHasRune(r) {
+		return cell
+	}
+	if a, ok := builtinMetrics().advance(r); ok {
+		return a
+	}
+	if unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+		return 0
+	}
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2 * cell
+	}
+	return cell
+}
+
+// builtinKern returns the kerning between a and b in the built-in font, in
+// pixels at scale 1.
+func builtinKern(a, b rune) float32 {
+	if a < 0 {
+		return 0
+	}
+	return builtinMetrics().kern(a, b)
+}
+
+// builtinTextSize returns the size of the text in the built-in font. It is
+// the same on all platforms.
+func builtinTextSize(text string, scale float32) (width, height int) {
+	if scale <= 0 {
+		return 0, 0
+	}
+	lines := 1
+	var lineW, maxLineW float32
+	prev := rune(-1)
+	for _, r := range text {
+		if r == '\n' {
+			lines++
+			lineW = 0
+			prev = -1
+			continue
+		}
+		lineW += builtinKern(prev, r) + builtinAdvance(r)
+		prev = r
+		if lineW > maxLineW {
+			maxLineW = lineW
+		}
+	}
+	width = int(maxLineW*scale + 0.5)
+	height = int(float32(lines)*builtinLineHeight*scale + 0.5)
+	return width, height
+}
+
+// builtinCellSize returns the size of a character cell of the built-in font
+// at the given scale, see builtinCellWidth.
+func builtinCellSize(scale float32) (width, height float32) {
+	return builtinCellWidth() * scale, builtinLineHeight * scale
+}
+
+// layoutBuiltinText places the text in the built-in font with its top-left
+// corner at x,y. It calls bitmap with the index in the font bitmap and the
+// position of the cell for every rune that is in it and fallback for all
+// visible glyphs of other runes. Either function can be nil to skip those
+// runes. Glyphs of other runes are rasterized even if fallback is nil.
+func layoutBuiltinText(
+	fallbackFont *Font,
+	text string,
+	x, y, scale float32,
+	bitmap func(index rune, x, y float32),
+	fallback func(g glyph, x, y, width, height float32),
+) {
+	cellW, cellH := builtinCellSize(scale)
+	var glyphScale float32
+	if fallbackFont != nil {
+		glyphScale = cellH / float32(fallbackFont.lineHeight)
+	}
+
+	penX, penY := x, y
+	prev := rune(-1)
+	for _, r := range text {
+		if r == '\n' {
+			penX = x
+			penY += cellH
+			prev = -1
+			continue
+		}
+
+		penX += builtinKern(prev, r) * scale
+		prev = r
+		advance := builtinAdvance(r) * scale
+		if bitmapHasRune(r) || fallbackFont == nil {
+			if bitmap != nil {
+				bitmap(runeToFont(r), penX+(advance-cellW)/2, penY)
+			}
+		} else if g := fallbackFont.glyph(r); fallback != nil && g.width > 0 && g.height > 0 {
+			// Center the glyph in its advance.
+			left := penX + (advance-g.advance*glyphScale)/2
+			baseline := penY + float32(fallbackFont.ascent)*glyphScale
+			fallback(
+				g,
+				left+float32(g.offsetX)*glyphScale,
+				baseline+float32(g.offsetY)*glyphScale,
+				float32(g.width)*glyphScale,
+				float32(g.height)*glyphScale,
+			)
+		}
+		penX += advance
+	}
+}
+
+// fontMap maps the runes that are in the font bitmap, other than ASCII, to
+// their cells.
+var fontMap = builtinFontMap()
+
+func builtinFontMap() map[rune]rune {
+	m := make(map[rune]rune, len(builtinfont.Runes)+len(fontLookAlikes))
+	for r, cell := range fontLookAlikes {
+		m[r] = cell
+	}
+	for cell, r := range builtinfont.Runes {
+		if cell != 0 && (cell < 32 || cell > 127) {
+			m[r] = rune(cell)
+		}
+	}
+	return m
+}
+
+// fontLookAlikes are drawn with the cells of other runes.
+var fontLookAlikes = map[rune]rune{
 	// Cyrillic letters that look like existing ones.
 	'Ѕ': 'S',
 	'І': 'I',
@@ -0,0 +1,123 @@
+package draw
+
+import "testing"
+
+func TestBitmapRunesTakeUpOneCell(t *testing.T) {
+	cell := builtinCellWidth()
+	if cell < 0.6*builtinFontSize || cell > 0.601*builtinFontSize {
+		t.Errorf("Go-Mono characters should be 0.6 em wide but are %v", cell)
+	}
+	for _, r := range "aZ~ä☺" {
+		if !bitmapHasRune(r) {
+			t.Fatalf("%q should be in the font bitmap", r)
+		}
+		if a := builtinAdvance(r); a != cell {
+			t.Errorf("%q: want advance %v but have %v", r, cell, a)
+		}
+	}
+}
+
+func TestRunesMissingInGoMonoAreMeasuredByWidth(t *testing.T) {
+	cell := builtinCellWidth()
+	checkAdvance := func(r rune, want float32) {
+		t.Helper()
+		if _, ok := builtinMetrics().advance(r); ok {
+			t.Fatalf("%q should not be in Go-Mono", r)
+		}
+		if a := builtinAdvance(r); a != want {
+			t.Errorf("%q: want advance %v but have %v", r, want, a)
+		}
+	}
+	checkAdvance('中', 2*cell)
+	checkAdvance('ア', 2*cell)
+	checkAdvance('​', 0)
+	checkAdvance('א', cell)
+}
+
+func TestRunesMissingInBitmapComeFromFallbackFont(t *testing.T) {
+	fallback := newBuiltinFallback()
+	if fallback == nil {
+		t.Fatal("the fallback font could not be created")
+	}
+	for _, r := range "łğλЖ" {
+		if bitmapHasRune(r) {
+			t.Fatalf("%q should not be in the font bitmap", r)
+		}
+		if fallback.faceFor(r) == nil {
+			t.Fatalf("%q should be in the fallback font", r)
+		}
+		if a := builtinAdvance(r); a != builtinCellWidth() {
+			t.Errorf("%q: want one cell but have %v", r, a)
+		}
+	}
+
+	cellW, cellH := builtinCellSize(2)
+	var bitmap, ttf int
+	layoutBuiltinText(fallback, "aλ\nb", 0, 0, 2,
+		func(index rune, x, y float32) {
+			bitmap++
+		},
+		func(g glyph, x, y, width, height float32) {
+			ttf++
+			if center := x + width/2; center < cellW || center > 2*cellW {
+				t.Errorf("λ should be in the second cell but is at %v..%v", x, x+width)
+			}
+			if y < 0 || y+height > cellH {
+				t.Errorf("λ should be in the first line but is at %v..%v", y, y+height)
+			}
+		},
+	)
+	if bitmap != 2 || ttf != 1 {
+		t.Errorf("want 2 bitmap and 1 fallback glyph but have %d and %d", bitmap, ttf)
+	}
+}
+
+func TestBuiltinTextIsMeasuredByAdvances(t *testing.T) {
+	// A cell is 8.1 and a line 16.74 pixels at scale 1.
+	checkBuiltinTextSize(t, "", 1, 0, 17)
+	checkBuiltinTextSize(t, "abc", 1, 24, 17)
+	checkBuiltinTextSize(t, "abc", 2, 49, 33)
+	checkBuiltinTextSize(t, "ab\nłğλЖx\n", 1, 41, 50)
+	checkBuiltinTextSize(t, "中文", 1, 32, 17)
+	checkBuiltinTextSize(t, "abc", 0, 0, 0)
+	checkBuiltinTextSize(t, "abc", -1, 0, 0)
+}
+
+func checkBuiltinTextSize(t *testing.T, text string, scale float32, wantW, wantH int) {
+	t.Helper()
+	w, h := builtinTextSize(text, scale)
+	if w != wantW || h != wantH {
+		t.Errorf("%q at scale %v: want %dx%d but have %dx%d", text, scale, wantW, wantH, w, h)
+	}
+}
+
+func TestBuiltinLayoutMatchesMeasurement(t *testing.T) {
+	text := "Hello, 世界!"
+	var right float32
+	cellW, _ := builtinCellSize(3)
+	layoutBuiltinText(nil, text, 0, 0, 3,
+		func(index rune, x, y float32) {
+			right = x + cellW
+		},
+		nil,
+	)
+	if w, _ := builtinTextSize(text, 3); int(right+0.5) != w {
+		t.Errorf("the last glyph ends at %v but the text is %d wide", right, w)
+	}
+}
+
+func TestFontMapHasBitmapCellsAndLookAlikes(t *testing.T) {
+	for r, want := range map[rune]rune{
+		'A': 'A',
+		'☺': 1,
+		'▼': 31,
+		'â': 128,
+		'Æ': 180,
+		'Ѕ': 'S',
+		'у': 'y',
+	} {
+		if cell := runeToFont(r); cell != want {
+			t.Errorf("%q: want cell %d but have %d", r, want, cell)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package draw
+
+import "time"
+
+// UnlimitedFrameRate can be passed to Window.SetFrameRate or used as
+// WindowOptions.FrameRate to call update as often as possible. With VSync
+// this means once per monitor refresh.
+const UnlimitedFrameRate = -1
+
+const defaultFrameRate = 60
+
+// normalizeFrameRate returns the default frame rate for 0 and
+// UnlimitedFrameRate for all negative rates.
+func normalizeFrameRate(rate int) int {
+	if rate == 0 {
+		return defaultFrameRate
+	}
+	if rate < 0 {
+		return UnlimitedFrameRate
+	}
+	return rate
+}
+
+// frameInterval returns the time between two updates at the given frame rate
+// or 0 if the frame rate is unlimited.
+func frameInterval(rate int) time.Duration {
+	rate = normalizeFrameRate(rate)
+	if rate == UnlimitedFrameRate {
+		return 0
+	}
+	return time.Second / time.Duration(rate)
+}
+
+// frameTimer measures the time between updates and the resulting frame rate.
+type frameTimer struct {
+	last  time.Time
+	delta time.Duration
+	frame int
+	// The FPS are averaged over about one second, starting at fpsStart.
+	fps       float64
+	fpsStart  time.Time
+	fpsFrames int
+}
+
+func newFrameTimer() frameTimer {
+	return frameTimer{frame: -1}
+}
+
+// startFrame is called right before each update with the time of the current
+// loop iteration. Updates that catch up on missed frames all get the same
+// time. At a fixed frame rate, every update stands for one frame interval so
+// we report that as the delta, no matter when exactly it runs. At an unlimited
+// frame rate, we measure the time since the last update. The first frame has
+// no previous one to measure against so it also gets the nominal interval.
+func (t *frameTimer) startFrame(now time.Time, rate int) {
+	t.frame++
+	interval := frameInterval(rate)
+	if t.frame == 0 {
+		if rate > 0 {
+			t.fps = float64(rate)
+		}
+		t.fpsStart = now
+	}
+	if t.frame == 0 || interval > 0 {
+		t.delta = interval
+	} else {
+		t.delta = now.Sub(t.last)
+	}
+	t.last = now
+
+	if elapsed := now.Sub(t.fpsStart); elapsed >= time.Second {
+		t.fps = float64(t.fpsFrames) / elapsed.Seconds()
+		t.fpsStart = now
+		t.fpsFrames = 0
+	}
+	t.fpsFrames++
+}
+
+func (t *frameTimer) deltaTime() float64 {
+	return t.delta.Seconds()
+}
+
+func (t *frameTimer) frameCount() int {
+	if t.frame < 0 {
+		return 0
+	}
+	return t.frame
+}
+
+// FrameStats describe the frame timing over the last full second. Use them to
+// check that frames are shown at an even pace.
+type FrameStats struct {
+	// Frames is the number of frames that were shown.
+	Frames int
+
+	// Updates is the number of times that update was called. It is larger
+	// than Frames if updates had to catch up on missed frames. In this case
+	// only the last update of each frame is shown.
+	Updates int
+
+	// MinFrameTime, MaxFrameTime and AverageFrameTime are the shortest,
+	// longest and average time between two shown frames.
+	MinFrameTime     time.Duration
+	MaxFrameTime     time.Duration
+	AverageFrameTime time.Duration
+}
+
+// frameStatsCollector gathers FrameStats for one second at a time. The stats
+// of the last full second are available in stats.
+type frameStatsCollector struct {
+	stats     FrameStats
+	current   FrameStats
+	start     time.Time
+	lastFrame time.Time
+	total     time.Duration
+	intervals int
+}
+
+// frameShown is called every time a frame is shown, after running the given
+// number of updates for it.
+func (c *frameStatsCollector) frameShown(now time.Time, updates int) {
+	if c.start.IsZero() {
+		c.start = now
+	} else {
+		dt := now.Sub(c.lastFrame)
+		if c.intervals == 0 || dt < c.current.MinFrameTime {
+			c.current.MinFrameTime = dt
+		}
+		if dt > c.current.MaxFrameTime {
+			c.current.MaxFrameTime = dt
+		}
+		c.total += dt
+		c.intervals++
+	}
+	c.lastFrame = now
+	c.current.Frames++
+	c.current.Updates += updates
+
+	if now.Sub(c.start) >= time.Second {
+		if c.intervals > 0 {
+			c.current.AverageFrameTime = c.total / time.Duration(c.intervals)
+		}
+		c.stats = c.current
+		c.current = FrameStats{}
+		c.start = now
+		c.total = 0
+		c.intervals = 0
+	}
+}
+
+// framePacer schedules the updates of a main loop. Every update has a deadline
+// that is one frame interval after the previous one so small delays do not add
+// up over time. If deadlines are missed, e.g. because update took too long,
+// the missed updates are run to catch up, up to maxUpdatesPerFrame.
+type framePacer struct {
+	next time.Time
+}
+
+// updates returns how many updates are due at time now and schedules the next
+// one. It returns 0 if the next update is not due yet. An update may run up to
+// tolerance early. An interval of 0 means the frame rate is unlimited, in which
+// case there is always one update due.
+func (p *framePacer) updates(now time.Time, interval, tolerance time.Duration) int {
+	if p.next.IsZero() || interval <= 0 {
+		p.next = now.Add(interval)
+		return 1
+	}
+	if now.Add(tolerance).Before(p.next) {
+		return 0
+	}
+	n := 1 + int(now.Sub(p.next)/interval)
+	if n < 1 {
+		n = 1
+	}
+	if n > maxUpdatesPerFrame {
+		// We are too far behind, skip the missed frames.
+		n = maxUpdatesPerFrame
+		p.next = now.Add(interval)
+	} else {
+		p.next = p.next.Add(time.Duration(n) * interval)
+	}
+	return n
+}
+
+// vsyncSchedule spreads updates over the monitor refreshes when VSync paces
+// the main loop. The frame rate does not have to be a multiple of the refresh
+// rate, e.g. a rate of 90 at 60 Hz alternates between 1 and 2 updates per
+// refresh.
+type vsyncSchedule struct {
+	due float32
+}
+
+// updates returns how many updates to run for the next monitor refresh.
+func (s *vsyncSchedule) updates(rate, refresh int) int {
+	if rate == UnlimitedFrameRate {
+		s.due = 0
+		return 1
+	}
+	if rate-2 <= refresh && refresh <= rate+2 {
+		// Close enough, treat it like the frame rate that we want.
+		refresh = rate
+	}
+	s.due += float32(rate) / float32(refresh)
+	n := 0
+	for s.due > 0 {
+		n++
+		s.due--
+	}
+	return n
+}
+
+// spinTime is how long before a deadline sleepUntil stops sleeping. time.Sleep
+// can oversleep by a millisecond or more, depending on the OS timer
+// resolution.
+const spinTime = 2 * time.Millisecond
+
+// sleepUntil waits until the deadline. It sleeps until shortly before it and
+// spins for the rest of the time to be precise.
+func sleepUntil(deadline time.Time) {
+	if d := time.Until(deadline) - spinTime; d > 0 {
+		time.Sleep(d)
+	}
+	for time.Now().Before(deadline) {
+	}
+}
@@ -0,0 +1,186 @@
+package draw
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFrameTimerMeasuresDeltaTimeAtUnlimitedFrameRate(t *testing.T) {
+	timer := newFrameTimer()
+	start := time.Unix(1000, 0)
+
+	timer.startFrame(start, UnlimitedFrameRate)
+	if timer.frameCount() != 0 {
+		t.Errorf("first frame should be 0 but is %d", timer.frameCount())
+	}
+
+	timer.startFrame(start.Add(20*time.Millisecond), UnlimitedFrameRate)
+	if timer.frameCount() != 1 {
+		t.Errorf("second frame should be 1 but is %d", timer.frameCount())
+	}
+	if timer.deltaTime() != 0.02 {
+		t.Errorf("want delta time 0.02 but have %v", timer.deltaTime())
+	}
+}
+
+func TestFrameTimerReportsNominalDeltaTimeAtFixedFrameRate(t *testing.T) {
+	timer := newFrameTimer()
+	start := time.Unix(1000, 0)
+
+	// 60 Hz updates on a 30 Hz display run twice per display frame, both with
+	// the same frame time.
+	for frame := 0; frame < 3; frame++ {
+		now := start.Add(time.Duration(frame) * time.Second / 30)
+		for i := 0; i < 2; i++ {
+			timer.startFrame(now, 60)
+			if timer.delta != time.Second/60 {
+				t.Fatalf("frame %d update %d: want delta %v but have %v",
+					frame, i, time.Second/60, timer.delta)
+			}
+		}
+	}
+	if timer.frameCount() != 5 {
+		t.Errorf("want frame count 5 but have %d", timer.frameCount())
+	}
+}
+
+func TestFrameTimerAveragesFPSOverOneSecond(t *testing.T) {
+	timer := newFrameTimer()
+	start := time.Unix(1000, 0)
+
+	// Run at 50 Hz while we want 60, the FPS report 60 until one second is
+	// measured.
+	for i := 0; i < 50; i++ {
+		timer.startFrame(start.Add(time.Duration(i)*20*time.Millisecond), 60)
+		if timer.fps != 60 {
+			t.Fatalf("frame %d: want initial FPS 60 but have %v", i, timer.fps)
+		}
+	}
+	timer.startFrame(start.Add(time.Second), 60)
+	if timer.fps != 50 {
+		t.Errorf("want 50 FPS but have %v", timer.fps)
+	}
+}
+
+func TestFrameRates(t *testing.T) {
+	checkInterval := func(rate int, want time.Duration) {
+		t.Helper()
+		if have := frameInterval(rate); have != want {
+			t.Errorf("rate %d: want interval %v but have %v", rate, want, have)
+		}
+	}
+	checkInterval(0, time.Second/60)
+	checkInterval(30, time.Second/30)
+	checkInterval(120, time.Second/120)
+	checkInterval(UnlimitedFrameRate, 0)
+	checkInterval(-5, 0)
+}
+
+func TestFrameStatsArePublishedEverySecond(t *testing.T) {
+	var c frameStatsCollector
+	start := time.Unix(1000, 0)
+
+	// 10ms, 20ms, 10ms, 20ms, ... with 2 updates for every second frame.
+	now := start
+	for i := 0; now.Sub(start) < time.Second; i++ {
+		updates := 1 + i%2
+		c.frameShown(now, updates)
+		if c.stats != (FrameStats{}) {
+			t.Fatalf("stats published before a second has passed: %+v", c.stats)
+		}
+		now = now.Add(time.Duration(10+10*(i%2)) * time.Millisecond)
+	}
+	c.frameShown(now, 1)
+
+	want := FrameStats{
+		Frames:           68,
+		Updates:          68 + 33,
+		MinFrameTime:     10 * time.Millisecond,
+		MaxFrameTime:     20 * time.Millisecond,
+		AverageFrameTime: time.Second / 67,
+	}
+	if c.stats != want {
+		t.Errorf("want\n%+v\nbut have\n%+v", want, c.stats)
+	}
+}
+
+func TestFramePacerKeepsDeadlinesOnAGrid(t *testing.T) {
+	var p framePacer
+	start := time.Unix(1000, 0)
+	interval := 10 * time.Millisecond
+
+	checkUpdates(t, &p, start, interval, 1)
+	checkUpdates(t, &p, start.Add(9*time.Millisecond), interval, 0)
+	// A late update does not move the following deadlines.
+	checkUpdates(t, &p, start.Add(13*time.Millisecond), interval, 1)
+	checkUpdates(t, &p, start.Add(19*time.Millisecond), interval, 0)
+	checkUpdates(t, &p, start.Add(20*time.Millisecond), interval, 1)
+}
+
+func TestFramePacerCatchesUpOnMissedFrames(t *testing.T) {
+	var p framePacer
+	start := time.Unix(1000, 0)
+	interval := 10 * time.Millisecond
+
+	checkUpdates(t, &p, start, interval, 1)
+	// Deadlines 10 and 20 were missed, 30 is not due yet.
+	checkUpdates(t, &p, start.Add(25*time.Millisecond), interval, 2)
+	checkUpdates(t, &p, start.Add(30*time.Millisecond), interval, 1)
+	// After a long pause we skip frames and start a new grid.
+	checkUpdates(t, &p, start.Add(time.Second), interval, maxUpdatesPerFrame)
+	checkUpdates(t, &p, start.Add(time.Second+9*time.Millisecond), interval, 0)
+	checkUpdates(t, &p, start.Add(time.Second+10*time.Millisecond), interval, 1)
+}
+
+func TestFramePacerToleratesEarlyUpdates(t *testing.T) {
+	var p framePacer
+	start := time.Unix(1000, 0)
+	interval := 10 * time.Millisecond
+
+	p.updates(start, interval, 2*time.Millisecond)
+	if n := p.updates(start.Add(7*time.Millisecond), interval, 2*time.Millisecond); n != 0 {
+		t.Errorf("want no update 3ms early but have %d", n)
+	}
+	if n := p.updates(start.Add(8*time.Millisecond), interval, 2*time.Millisecond); n != 1 {
+		t.Errorf("want 1 update 2ms early but have %d", n)
+	}
+}
+
+func TestFramePacerUpdatesEveryTimeIfUnlimited(t *testing.T) {
+	var p framePacer
+	now := time.Unix(1000, 0)
+	for i := 0; i < 10; i++ {
+		checkUpdates(t, &p, now, 0, 1)
+	}
+}
+
+func TestVSyncScheduleRunsSeveralUpdatesPerRefresh(t *testing.T) {
+	for _, test := range []struct {
+		rate, refresh int
+		want          []int
+	}{
+		{120, 60, []int{2, 2, 2, 2}},
+		{90, 60, []int{2, 1, 2, 1}},
+		{60, 61, []int{1, 1, 1, 1}},
+		{30, 60, []int{1, 0, 1, 0}},
+		{UnlimitedFrameRate, 60, []int{1, 1, 1, 1}},
+	} {
+		var s vsyncSchedule
+		var have []int
+		for range test.want {
+			have = append(have, s.updates(test.rate, test.refresh))
+		}
+		if !reflect.DeepEqual(have, test.want) {
+			t.Errorf("%d at %d Hz: want updates %v but have %v",
+				test.rate, test.refresh, test.want, have)
+		}
+	}
+}
+
+func checkUpdates(t *testing.T, p *framePacer, now time.Time, interval time.Duration, want int) {
+	t.Helper()
+	if n := p.updates(now, interval, 0); n != want {
+		t.Errorf("want %d updates but have %d", want, n)
+	}
+}
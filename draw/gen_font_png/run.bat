@@ -1,2 +0,0 @@
-zig cc main.c -o gen_font_png.exe && gen_font_png.exe
-go run make_font_transparent.go
@@ -0,0 +1,57 @@
+package draw
+
+import "sort"
+
+// Monitor describes a display connected to the computer, see Window.Monitors.
+type Monitor struct {
+	// Name is the monitor's name as reported by the operating system.
+	Name string
+
+	// X, Y, Width and Height are the monitor's area on the desktop, in
+	// pixels.
+	X, Y, Width, Height int
+
+	// WorkX, WorkY, WorkWidth and WorkHeight are the part of the monitor that
+	// is not covered by the task bar or other system UI.
+	WorkX, WorkY, WorkWidth, WorkHeight int
+
+	// Primary is true for the main monitor.
+	Primary bool
+
+	// CurrentMode is the video mode that the monitor currently runs at.
+	CurrentMode VideoMode
+
+	// Modes are all video modes that the monitor supports, sorted by width,
+	// then height, then refresh rate.
+	Modes []VideoMode
+}
+
+// VideoMode is a resolution and refresh rate that a monitor can run at. A
+// RefreshRate of 0 means it is unknown.
+type VideoMode struct {
+	Width, Height int
+	RefreshRate   int
+}
+
+// sortVideoModes sorts the modes as documented for Monitor.Modes and removes
+// duplicates. The operating system may report a mode multiple times, e.g. with
+// different color depths.
+func sortVideoModes(modes []VideoMode) []VideoMode {
+	sort.Slice(modes, func(i, j int) bool {
+		a, b := modes[i], modes[j]
+		if a.Width != b.Width {
+			return a.Width < b.Width
+		}
+		if a.Height != b.Height {
+			return a.Height < b.Height
+		}
+		return a.RefreshRate < b.RefreshRate
+	})
+	unique := modes[:0]
+	for i, m := range modes {
+		if i == 0 || m != modes[i-1] {
+			unique = append(unique, m)
+		}
+	}
+	return unique
+}
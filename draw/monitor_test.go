@@ -0,0 +1,34 @@
+package draw
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVideoModesAreSortedWithoutDuplicates(t *testing.T) {
+	modes := sortVideoModes([]VideoMode{
+		{1920, 1080, 60},
+		{800, 600, 60},
+		{1920, 1080, 144},
+		{1920, 1080, 60},
+		{1920, 1200, 60},
+		{800, 600, 60},
+		{1280, 720, 60},
+	})
+	want := []VideoMode{
+		{800, 600, 60},
+		{1280, 720, 60},
+		{1920, 1080, 60},
+		{1920, 1080, 144},
+		{1920, 1200, 60},
+	}
+	if !reflect.DeepEqual(modes, want) {
+		t.Errorf("want\n%v\nbut have\n%v", want, modes)
+	}
+}
+
+func TestSortingNoVideoModesGivesNone(t *testing.T) {
+	if modes := sortVideoModes(nil); len(modes) != 0 {
+		t.Errorf("want no modes but have %v", modes)
+	}
+}
@@ -0,0 +1,221 @@
+package draw
+
+import (
+	"image"
+	"image/draw"
+	"strings"
+)
+
+// ninePatchInsets are the sizes of the unscaled corners of a nine-patch image.
+type ninePatchInsets struct {
+	left, top, right, bottom int
+}
+
+// ninePatchPart is a rectangle of the source image that is drawn to the
+// destination rectangle.
+type ninePatchPart struct {
+	srcX, srcY, srcW, srcH     int
+	destX, destY, destW, destH int
+}
+
+// isNinePatchFile reports whether the path is an Android-style nine-patch
+// image, which has the stretchable area marked in its 1 pixel border.
+func isNinePatchFile(path string) bool {
+	return strings.HasSuffix(strings.ToLower(path), ".9.png")
+}
+
+// readNinePatch reads the markers of an Android-style nine-patch image. The
+// top row of pixels has black pixels above the horizontally stretchable area,
+// the left column has black pixels next to the vertically stretchable area.
+// The right and bottom markers define the content padding which we ignore.
+// It returns the insets relative to the image without its border, and that
+// inner image, which starts at 0,0.
+func readNinePatch(img image.Image) (ninePatchInsets, image.Image) {
+	b := img.Bounds()
+	if b.Dx() < 3 || b.Dy() < 3 {
+		return ninePatchInsets{}, img
+	}
+	inner := b.Inset(1)
+
+	isMarker := func(x, y int) bool {
+		r, g, b, a := img.At(x, y).RGBA()
+		return a > 0x8000 && r < 0x8000 && g < 0x8000 && b < 0x8000
+	}
+
+	markedRange := func(n int, marked func(i int) bool) (start, end int) {
+		start, end = -1, -1
+		for i := 0; i < n; i++ {
+			if marked(i) {
+				if start == -1 {
+					start = i
+				}
+				end = i + 1
+			}
+		}
+		if start == -1 {
+			return 0, n
+		}
+		return start, end
+	}
+
+	left, right := markedRange(inner.Dx(), func(i int) bool {
+		return isMarker(inner.Min.X+i, b.Min.Y)
+	})
+	top, bottom := markedRange(inner.Dy(), func(i int) bool {
+		return isMarker(b.Min.X, inner.Min.Y+i)
+	})
+
+	insets := ninePatchInsets{
+		left:   left,
+		top:    top,
+		right:  inner.Dx() - right,
+		bottom: inner.Dy() - bottom,
+	}
+
+	innerImg := image.NewNRGBA(image.Rect(0, 0, inner.Dx(), inner.Dy()))
+	draw.Draw(innerImg, innerImg.Bounds(), img, inner.Min, draw.Src)
+	return insets, innerImg
+}
+
+// ninePatchParts splits a nine-patch image of size imageW x imageH into the
+// parts that draw it to the destination rectangle x,y,w,h. The corners keep
+// their size unless the destination is too small for them, in which case they
+// are shrunk. The edges and center are stretched or, if tile is true, repeated.
+func ninePatchParts(
+	imageW, imageH int,
+	insets ninePatchInsets,
+	x, y, w, h int,
+	tile bool,
+) []ninePatchPart {
+	if w <= 0 || h <= 0 || imageW <= 0 || imageH <= 0 {
+		return nil
+	}
+
+	srcX, srcW := ninePatchSplit(imageW, insets.left, insets.right)
+	srcY, srcH := ninePatchSplit(imageH, insets.top, insets.bottom)
+
+	destX, destW := ninePatchDestSplit(x, w, srcW[0], srcW[2])
+	destY, destH := ninePatchDestSplit(y, h, srcH[0], srcH[2])
+
+	parts := make([]ninePatchPart, 0, 9)
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 3; col++ {
+			if srcW[col] <= 0 || srcH[row] <= 0 || destW[col] <= 0 || destH[row] <= 0 {
+				continue
+			}
+
+			part := ninePatchPart{
+				srcX: srcX[col], srcY: srcY[row],
+				srcW: srcW[col], srcH: srcH[row],
+				destX: destX[col], destY: destY[row],
+				destW: destW[col], destH: destH[row],
+			}
+
+			tileX := tile && col == 1
+			tileY := tile && row == 1
+			if !tileX && !tileY {
+				parts = append(parts, part)
+				continue
+			}
+
+			// Repeat the source rectangle in its original size and cut off
+			// the last repetition at the end of the destination.
+			stepW, stepH := part.destW, part.destH
+			if tileX {
+				stepW = part.srcW
+			}
+			if tileY {
+				stepH = part.srcH
+			}
+			for dy := 0; dy < part.destH; dy += stepH {
+				for dx := 0; dx < part.destW; dx += stepW {
+					p := part
+					p.destX += dx
+					p.destY += dy
+					p.destW = minInt(stepW, part.destW-dx)
+					p.destH = minInt(stepH, part.destH-dy)
+					if tileX {
+						p.srcW = p.destW
+					}
+					if tileY {
+						p.srcH = p.destH
+					}
+					parts = append(parts, p)
+				}
+			}
+		}
+	}
+	return parts
+}
+
+// texRect returns the part's source rectangle in texels. Linear filtering
+// reads up to half a texel around each sample so at its edges, a stretched
+// part would blend in texels of its neighbors and stretch them as well. When
+// blurring, stretched parts are sampled half a texel inside their edges.
+// Unstretched parts sample exactly at texel centers and need no inset.
+func (p ninePatchPart) texRect(blur bool) (x, y, w, h float32) {
+	x, w = ninePatchTexRange(p.srcX, p.srcW, p.destW, blur)
+	y, h = ninePatchTexRange(p.srcY, p.srcH, p.destH, blur)
+	return
+}
+
+func ninePatchTexRange(pos, size, destSize int, blur bool) (start, length float32) {
+	start, length = float32(pos), float32(size)
+	if blur && size != destSize {
+		inset := float32(0.5)
+		if size == 1 {
+			// Keep a tiny range, browsers draw nothing for an empty source
+			// rectangle.
+			inset = 0.499
+		}
+		start += inset
+		length -= 2 * inset
+	}
+	return
+}
+
+// ninePatchSplit returns the start and size of the three source image
+// columns or rows, given the two corner insets.
+func ninePatchSplit(size, start, end int) (pos, length [3]int) {
+	start = clamp(start, 0, size)
+	end = clamp(end, 0, size-start)
+	pos = [3]int{0, start, size - end}
+	length = [3]int{start, size - start - end, end}
+	return
+}
+
+// ninePatchDestSplit returns the start and size of the three destination
+// columns or rows. The corners shrink proportionally if they do not fit.
+func ninePatchDestSplit(pos, size, start, end int) (destPos, destLength [3]int) {
+	if start+end > size {
+		newStart := start * size / (start + end)
+		start, end = newStart, size-newStart
+	}
+	destPos = [3]int{pos, pos + start, pos + size - end}
+	destLength = [3]int{start, size - start - end, end}
+	return
+}
+
+func clamp(x, low, high int) int {
+	if x < low {
+		return low
+	}
+	if x > high {
+		return high
+	}
+	return x
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
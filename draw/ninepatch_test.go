@@ -0,0 +1,107 @@
+package draw
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestNinePatchKeepsCornersAndStretchesTheRest(t *testing.T) {
+	// A 10x10 image with corners 2,3,4,1 drawn to 30x20 at 100,200.
+	parts := ninePatchParts(10, 10, ninePatchInsets{2, 3, 4, 1}, 100, 200, 30, 20, false)
+	checkParts(t, parts,
+		ninePatchPart{0, 0, 2, 3, 100, 200, 2, 3},
+		ninePatchPart{2, 0, 4, 3, 102, 200, 24, 3},
+		ninePatchPart{6, 0, 4, 3, 126, 200, 4, 3},
+		ninePatchPart{0, 3, 2, 6, 100, 203, 2, 16},
+		ninePatchPart{2, 3, 4, 6, 102, 203, 24, 16},
+		ninePatchPart{6, 3, 4, 6, 126, 203, 4, 16},
+		ninePatchPart{0, 9, 2, 1, 100, 219, 2, 1},
+		ninePatchPart{2, 9, 4, 1, 102, 219, 24, 1},
+		ninePatchPart{6, 9, 4, 1, 126, 219, 4, 1},
+	)
+}
+
+func TestNinePatchCornersShrinkIfTheyDoNotFit(t *testing.T) {
+	parts := ninePatchParts(8, 8, ninePatchInsets{4, 2, 4, 2}, 0, 0, 6, 4, false)
+	checkParts(t, parts,
+		ninePatchPart{0, 0, 4, 2, 0, 0, 3, 2},
+		ninePatchPart{4, 0, 4, 2, 3, 0, 3, 2},
+		ninePatchPart{0, 6, 4, 2, 0, 2, 3, 2},
+		ninePatchPart{4, 6, 4, 2, 3, 2, 3, 2},
+	)
+}
+
+func TestNinePatchEdgesCanBeTiled(t *testing.T) {
+	// The center column is 2 pixels wide and is repeated 2.5 times.
+	parts := ninePatchParts(4, 1, ninePatchInsets{1, 0, 1, 0}, 0, 0, 7, 1, true)
+	checkParts(t, parts,
+		ninePatchPart{0, 0, 1, 1, 0, 0, 1, 1},
+		ninePatchPart{1, 0, 2, 1, 1, 0, 2, 1},
+		ninePatchPart{1, 0, 2, 1, 3, 0, 2, 1},
+		ninePatchPart{1, 0, 1, 1, 5, 0, 1, 1},
+		ninePatchPart{3, 0, 1, 1, 6, 0, 1, 1},
+	)
+}
+
+func TestBlurredNinePatchPartsDoNotSampleTheirNeighbors(t *testing.T) {
+	parts := ninePatchParts(10, 10, ninePatchInsets{2, 3, 4, 1}, 0, 0, 30, 20, false)
+	checkTexRect := func(p ninePatchPart, blur bool, wantX, wantY, wantW, wantH float32) {
+		t.Helper()
+		x, y, w, h := p.texRect(blur)
+		if x != wantX || y != wantY || w != wantW || h != wantH {
+			t.Errorf("%+v blur %v: want %v,%v,%v,%v but have %v,%v,%v,%v",
+				p, blur, wantX, wantY, wantW, wantH, x, y, w, h)
+		}
+	}
+	// The top-left corner is not stretched.
+	checkTexRect(parts[0], true, 0, 0, 2, 3)
+	// The top edge is stretched horizontally only.
+	checkTexRect(parts[1], true, 2.5, 0, 3, 3)
+	checkTexRect(parts[1], false, 2, 0, 4, 3)
+	// The center is stretched in both directions.
+	checkTexRect(parts[4], true, 2.5, 3.5, 3, 5)
+	// A single stretched texel keeps a tiny range around its center.
+	p := ninePatchPart{srcX: 4, srcW: 1, destW: 5, srcH: 1, destH: 1}
+	if x, _, w, _ := p.texRect(true); x <= 4 || x+w >= 5 || w <= 0 {
+		t.Errorf("want a range inside texel 4 but have %v..%v", x, x+w)
+	}
+}
+
+func TestNinePatchMarkersAreReadFromBorder(t *testing.T) {
+	// 8x6 image with a 6x4 content area. The stretchable area is marked from
+	// content pixel 2 to 4 horizontally and 1 to 2 vertically.
+	img := image.NewNRGBA(image.Rect(0, 0, 8, 6))
+	black := color.NRGBA{A: 255}
+	for x := 3; x <= 5; x++ {
+		img.Set(x, 0, black)
+	}
+	for y := 2; y <= 3; y++ {
+		img.Set(0, y, black)
+	}
+	content := color.NRGBA{R: 255, A: 255}
+	img.Set(1, 1, content)
+
+	insets, inner := readNinePatch(img)
+	if insets != (ninePatchInsets{2, 1, 1, 1}) {
+		t.Errorf("wrong insets: %+v", insets)
+	}
+	if inner.Bounds() != image.Rect(0, 0, 6, 4) {
+		t.Errorf("wrong inner bounds: %v", inner.Bounds())
+	}
+	if inner.At(0, 0) != content {
+		t.Errorf("inner image does not start at the content")
+	}
+}
+
+func checkParts(t *testing.T, have []ninePatchPart, want ...ninePatchPart) {
+	t.Helper()
+	if len(have) != len(want) {
+		t.Fatalf("want %d parts but have %d: %v", len(want), len(have), have)
+	}
+	for i := range want {
+		if have[i] != want[i] {
+			t.Errorf("part %d: want %v but have %v", i, want[i], have[i])
+		}
+	}
+}
@@ -12,3 +12,7 @@ import (
 var DefaultOpenFile = func(path string) (io.ReadCloser, error) {
 	return os.Open(path)
 }
+
+func fetchFile(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
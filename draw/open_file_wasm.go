@@ -3,8 +3,63 @@
 
 package draw
 
-import "io"
+import (
+	"errors"
+	"io"
+	"strconv"
+	"syscall/js"
+)
 
 // DefaultOpenFile for WASM builds is nil so that the WASM port knows to load
 // from URL.
 var DefaultOpenFile func(path string) (io.ReadCloser, error) = nil
+
+// fetchFile downloads the file at the given URL. It blocks until the
+// JavaScript promises resolve, so it must not be called from a JavaScript
+// callback.
+func fetchFile(url string) ([]byte, error) {
+	resp, err := await(js.Global().Call("fetch", url))
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Get("ok").Bool() {
+		return nil, errors.New("failed to fetch \"" + url + "\": " +
+			strconv.Itoa(resp.Get("status").Int()) + " " + resp.Get("statusText").String())
+	}
+
+	buffer, err := await(resp.Call("arrayBuffer"))
+	if err != nil {
+		return nil, err
+	}
+
+	array := js.Global().Get("Uint8Array").New(buffer)
+	data := make([]byte, array.Get("length").Int())
+	js.CopyBytesToGo(data, array)
+	return data, nil
+}
+
+// await blocks until the given JavaScript promise is settled and returns its
+// result.
+func await(promise js.Value) (js.Value, error) {
+	type result struct {
+		value js.Value
+		err   error
+	}
+	done := make(chan result, 1)
+
+	onSuccess := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		done <- result{value: args[0]}
+		return nil
+	})
+	defer onSuccess.Release()
+
+	onFailure := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		done <- result{err: js.Error{Value: args[0]}}
+		return nil
+	})
+	defer onFailure.Release()
+
+	promise.Call("then", onSuccess, onFailure)
+	r := <-done
+	return r.value, r.err
+}
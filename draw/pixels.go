@@ -0,0 +1,73 @@
+package draw
+
+import "image"
+
+// pixelRows copies the image's pixels to buf, without gaps between rows. If
+// pad is true, the last column and row are repeated once more. Images
+// are drawn from the top-left of a texture that may be larger than them and
+// the repeated pixels keep linear filtering from blending in the texels next
+// to the image. buf is grown if it is too small and the result is returned
+// with its size in pixels.
+func pixelRows(img *image.RGBA, buf []byte, pad bool) (pix []byte, width, height int) {
+	w, h := img.Rect.Dx(), img.Rect.Dy()
+	if w <= 0 || h <= 0 {
+		return buf[:0], 0, 0
+	}
+	width, height = w, h
+	if pad {
+		width, height = w+1, h+1
+	}
+	size := 4 * width * height
+	if cap(buf) < size {
+		buf = make([]byte, size)
+	}
+	buf = buf[:size]
+
+	start := img.PixOffset(img.Rect.Min.X, img.Rect.Min.Y)
+	stride := 4 * width
+	for y := 0; y < h; y++ {
+		row := img.Pix[start+y*img.Stride : start+y*img.Stride+4*w]
+		copy(buf[y*stride:], row)
+		if pad {
+			copy(buf[y*stride+4*w:], row[4*w-4:])
+		}
+	}
+	if pad {
+		copy(buf[h*stride:], buf[(h-1)*stride:h*stride])
+	}
+	return buf, width, height
+}
+
+// unpremultiplyPixels converts RGBA pixels from premultiplied to straight
+// alpha in place.
+func unpremultiplyPixels(pix []byte) {
+	if unpremultiplied == nil {
+		unpremultiplied = make([]byte, 256*256)
+		for a := 1; a < 256; a++ {
+			for c := 0; c < 256; c++ {
+				unpremultiplied[a*256+c] = unpremultiply(byte(c), byte(a))
+			}
+		}
+	}
+	for i := 0; i < len(pix); i += 4 {
+		a := int(pix[i+3])
+		if a != 255 && a != 0 {
+			row := unpremultiplied[a*256 : a*256+256]
+			pix[i+0] = row[pix[i+0]]
+			pix[i+1] = row[pix[i+1]]
+			pix[i+2] = row[pix[i+2]]
+		}
+	}
+}
+
+// unpremultiplied holds the straight color for every alpha a and premultiplied
+// color c at index a*256+c. It is created on first use.
+var unpremultiplied []byte
+
+func unpremultiply(c, a byte) byte {
+	straight := (int(c)*255 + int(a)/2) / int(a)
+	if straight > 255 {
+		return 255
+	}
+	return byte(straight)
+}
@@ -0,0 +1,81 @@
+package draw
+
+import (
+	"bytes"
+	"image"
+	"testing"
+)
+
+func TestPixelRowsCopiesRegionWithoutGaps(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 3, 3))
+	for i := range img.Pix {
+		img.Pix[i] = byte(i)
+	}
+	for i := 3; i < len(img.Pix); i += 4 {
+		img.Pix[i] = 255
+	}
+	sub := img.SubImage(image.Rect(1, 1, 3, 3)).(*image.RGBA)
+
+	pix, w, h := pixelRows(sub, nil, false)
+	if w != 2 || h != 2 {
+		t.Errorf("want size 2x2 but have %dx%d", w, h)
+	}
+	want := []byte{
+		16, 17, 18, 255, 20, 21, 22, 255,
+		28, 29, 30, 255, 32, 33, 34, 255,
+	}
+	if !bytes.Equal(pix, want) {
+		t.Errorf("want\n%v\nbut have\n%v", want, pix)
+	}
+}
+
+func TestUnpremultiplyPixelsRemovesPremultipliedAlpha(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 3, 1))
+	copy(img.Pix, []byte{
+		64, 32, 0, 128,
+		0, 0, 0, 0,
+		255, 10, 20, 255,
+	})
+	pix, _, _ := pixelRows(img, nil, false)
+	unpremultiplyPixels(pix)
+	want := []byte{
+		128, 64, 0, 128,
+		0, 0, 0, 0,
+		255, 10, 20, 255,
+	}
+	if !bytes.Equal(pix, want) {
+		t.Errorf("want\n%v\nbut have\n%v", want, pix)
+	}
+	if img.Pix[0] != 64 {
+		t.Error("the image must not be changed")
+	}
+}
+
+func TestPixelRowsReusesBuffer(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	buf := make([]byte, 100)
+	pix, _, _ := pixelRows(img, buf, false)
+	if len(pix) != 16 || &pix[0] != &buf[0] {
+		t.Error("the buffer was not reused")
+	}
+}
+
+func TestPixelRowsRepeatLastColumnAndRow(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	copy(img.Pix, []byte{
+		1, 1, 1, 1, 2, 2, 2, 2,
+		3, 3, 3, 3, 4, 4, 4, 4,
+	})
+	pix, w, h := pixelRows(img, nil, true)
+	if w != 3 || h != 3 {
+		t.Errorf("want size 3x3 but have %dx%d", w, h)
+	}
+	want := []byte{
+		1, 1, 1, 1, 2, 2, 2, 2, 2, 2, 2, 2,
+		3, 3, 3, 3, 4, 4, 4, 4, 4, 4, 4, 4,
+		3, 3, 3, 3, 4, 4, 4, 4, 4, 4, 4, 4,
+	}
+	if !bytes.Equal(pix, want) {
+		t.Errorf("want\n%v\nbut have\n%v", want, pix)
+	}
+}
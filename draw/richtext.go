@@ -0,0 +1,381 @@
+package draw
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// richTextColors are the color names that can be used in rich text markup.
+var richTextColors = map[string]Color{
+	"black":       Black,
+	"white":       White,
+	"gray":        Gray,
+	"lightgray":   LightGray,
+	"darkgray":    DarkGray,
+	"red":         Red,
+	"lightred":    LightRed,
+	"darkred":     DarkRed,
+	"green":       Green,
+	"lightgreen":  LightGreen,
+	"darkgreen":   DarkGreen,
+	"blue":        Blue,
+	"lightblue":   LightBlue,
+	"darkblue":    DarkBlue,
+	"purple":      Purple,
+	"lightpurple": LightPurple,
+	"darkpurple":  DarkPurple,
+	"yellow":      Yellow,
+	"lightyellow": LightYellow,
+	"darkyellow":  DarkYellow,
+	"cyan":        Cyan,
+	"lightcyan":   LightCyan,
+	"darkcyan":    DarkCyan,
+	"brown":       Brown,
+	"lightbrown":  LightBrown,
+}
+
+// richStyle is the formatting of a part of rich text.
+type richStyle struct {
+	color Color
+	scale float32
+	bold  bool
+	wave  bool
+	shake bool
+}
+
+// richSpan is a part of a line of rich text that has the same style.
+type richSpan struct {
+	text string
+	richStyle
+}
+
+// richLine is a line of rich text. Its scale is the largest scale of its
+// spans, or the scale at its start if it is empty.
+type richLine struct {
+	spans []richSpan
+	scale float32
+}
+
+// parseRichText splits the markup text into lines of styled spans. The text
+// starts in the given style. Tags that are not understood are kept as text.
+func parseRichText(text string, style richStyle) []richLine {
+	var (
+		lines  []richLine
+		line   = richLine{scale: style.scale}
+		span   strings.Builder
+		colors []Color
+		scales []float32
+		bold   int
+		wave   int
+		shake  int
+	)
+	base := style
+
+	flush := func() {
+		if span.Len() > 0 {
+			line.spans = append(line.spans, richSpan{text: span.String(), richStyle: style})
+			if style.scale > line.scale {
+				line.scale = style.scale
+			}
+			span.Reset()
+		}
+	}
+	restyle := func() {
+		style = base
+		if len(colors) > 0 {
+			style.color = colors[len(colors)-1]
+		}
+		if len(scales) > 0 {
+			style.scale = scales[len(scales)-1]
+		}
+		style.bold = bold > 0
+		style.wave = wave > 0
+		style.shake = shake > 0
+	}
+
+	for len(text) > 0 {
+		if strings.HasPrefix(text, "[[") {
+			span.WriteByte('[')
+			text = text[2:]
+			continue
+		}
+		if text[0] == '\n' {
+			flush()
+			lines = append(lines, line)
+			line = richLine{scale: style.scale}
+			text = text[1:]
+			continue
+		}
+
+		end := strings.IndexByte(text, ']')
+		if text[0] != '[' || end == -1 || strings.IndexByte(text[:end], '\n') != -1 {
+			span.WriteByte(text[0])
+			text = text[1:]
+			continue
+		}
+
+		tag := text[1:end]
+		name, value := tag, ""
+		if i := strings.IndexByte(tag, '='); i != -1 {
+			name, value = tag[:i], tag[i+1:]
+		}
+		known := true
+		switch strings.ToLower(name) {
+		case "color":
+			if c, ok := parseRichTextColor(value); ok {
+				flush()
+				colors = append(colors, c)
+			} else {
+				known = false
+			}
+		case "/color":
+			flush()
+			if len(colors) > 0 {
+				colors = colors[:len(colors)-1]
+			}
+		case "scale":
+			if s, err := strconv.ParseFloat(value, 32); err == nil && s > 0 {
+				flush()
+				scales = append(scales, base.scale*float32(s))
+			} else {
+				known = false
+			}
+		case "/scale":
+			flush()
+			if len(scales) > 0 {
+				scales = scales[:len(scales)-1]
+			}
+		case "b":
+			flush()
+			bold++
+		case "/b":
+			flush()
+			bold = maxInt(0, bold-1)
+		case "wave":
+			flush()
+			wave++
+		case "/wave":
+			flush()
+			wave = maxInt(0, wave-1)
+		case "shake":
+			flush()
+			shake++
+		case "/shake":
+			flush()
+			shake = maxInt(0, shake-1)
+		default:
+			known = false
+		}
+
+		if known {
+			restyle()
+		} else {
+			span.WriteString(text[:end+1])
+		}
+		text = text[end+1:]
+	}
+	flush()
+	return append(lines, line)
+}
+
+// parseRichTextColor parses a color name or #RRGGBB or #RRGGBBAA.
+func parseRichTextColor(s string) (Color, bool) {
+	if c, ok := richTextColors[strings.ToLower(s)]; ok {
+		return c, true
+	}
+	if len(s) != 7 && len(s) != 9 || s[0] != '#' {
+		return Color{}, false
+	}
+	v, err := strconv.ParseUint(s[1:], 16, 32)
+	if err != nil {
+		return Color{}, false
+	}
+	if len(s) == 7 {
+		v = v<<8 | 0xFF
+	}
+	return RGBA(
+		float32(v>>24&0xFF)/255,
+		float32(v>>16&0xFF)/255,
+		float32(v>>8&0xFF)/255,
+		float32(v&0xFF)/255,
+	), true
+}
+
+// scaledTextMeasure returns the size of text at a scale, like
+// Window.GetScaledTextSize.
+type scaledTextMeasure func(text string, scale float32) (width, height int)
+
+// placedSpan is a span of rich text at its position relative to the text.
+type placedSpan struct {
+	richSpan
+	x, y int
+	// index is the number of runes before the span, so effects continue
+	// smoothly across spans.
+	index int
+}
+
+// layoutRichText positions the spans of the markup text and returns them with
+// the size of the whole text. Spans on the same line are aligned at the
+// bottom. Text that does not change the scale has the same size as its plain
+// text with Window.GetScaledTextSize, including the kerning between spans.
+func layoutRichText(
+	text string,
+	scale float32,
+	color Color,
+	measure scaledTextMeasure,
+) (spans []placedSpan, width, height int) {
+	lines := parseRichText(text, richStyle{color: color, scale: scale})
+
+	index := 0
+	top := 0
+	for i := 0; i < len(lines); {
+		// Consecutive lines with the same scale are measured together, just
+		// like multi-line text in GetScaledTextSize.
+		runScale := lines[i].scale
+		_, lineH := measure("", runScale)
+		n := 0
+		for i+n < len(lines) && lines[i+n].scale == runScale {
+			line := lines[i+n]
+			_, h := measure(strings.Repeat("\n", n), runScale)
+			lineTop := top + h - lineH
+
+			// Neighboring spans with the same scale are measured together, so
+			// the kerning between them counts like in GetScaledTextSize.
+			x := 0
+			runX, run, spanScale := 0, "", float32(0)
+			for _, span := range line.spans {
+				if span.scale != spanScale {
+					runX, run, spanScale = x, "", span.scale
+				}
+				spanX := x
+				if first, size := utf8.DecodeRuneInString(span.text); run != "" && size > 0 {
+					// The span starts where its first rune is in the run.
+					withFirst, _ := measure(run+string(first), spanScale)
+					firstW, _ := measure(string(first), spanScale)
+					spanX = runX + withFirst - firstW
+				}
+				run += span.text
+				runW, _ := measure(run, spanScale)
+				x = runX + runW
+
+				_, spanH := measure("", span.scale)
+				spans = append(spans, placedSpan{
+					richSpan: span,
+					x:        spanX,
+					y:        lineTop + lineH - spanH,
+					index:    index,
+				})
+				index += len([]rune(span.text))
+			}
+			if x > width {
+				width = x
+			}
+			n++
+		}
+		_, runH := measure(strings.Repeat("\n", n-1), runScale)
+		top += runH
+		i += n
+	}
+
+	return spans, width, top
+}
+
+// richTextStart is the time that animated rich text effects are based on.
+var richTextStart = time.Now()
+
+const (
+	richTextWaveSpeed     = 6   // radians per second
+	richTextWavePhase     = 0.6 // radians per character
+	richTextWaveHeight    = 0.12
+	richTextShakeRate     = 20 // changes per second
+	richTextShakeDistance = 0.06
+)
+
+// drawRichText draws the markup text at x,y with drawText, which draws plain
+// text like Window.DrawScaledText. The shadow and outline of all spans are
+// drawn before the text, so they never cover neighboring spans.
+func drawRichText(
+	text string,
+	x, y int,
+	scale float32,
+	color Color,
+	measure scaledTextMeasure,
+	effects textEffects,
+	drawText textDrawer,
+	drawOutline outlineDrawer,
+) {
+	if scale <= 0 {
+		return
+	}
+
+	spans, _, _ := layoutRichText(text, scale, color, measure)
+	seconds := time.Since(richTextStart).Seconds()
+	for _, layer := range effects.layers() {
+		for _, span := range spans {
+			drawRichSpan(span, x, y, seconds, measure, func(text string, x, y int) {
+				layer.draw(text, x, y, span.scale, span.color, drawText, drawOutline)
+			})
+		}
+	}
+}
+
+// drawRichSpan draws the span at its position relative to x,y and with its
+// effects at the given time. draw draws plain text in the span's style.
+func drawRichSpan(
+	span placedSpan,
+	x, y int,
+	seconds float64,
+	measure scaledTextMeasure,
+	draw func(text string, x, y int),
+) {
+	spanX, spanY := x+span.x, y+span.y
+	_, spanH := measure("", span.scale)
+
+	drawStyled := func(text string, x, y int) {
+		draw(text, x, y)
+		if span.bold {
+			draw(text, x+maxInt(1, spanH/16), y)
+		}
+	}
+
+	if !span.wave && !span.shake {
+		drawStyled(span.text, spanX, spanY)
+		return
+	}
+
+	// Animated spans are drawn rune by rune, each with its own offset.
+	index := span.index
+	for i, r := range span.text {
+		runeX, _ := measure(span.text[:i], span.scale)
+		var dx, dy float64
+		if span.wave {
+			phase := seconds*richTextWaveSpeed - float64(index)*richTextWavePhase
+			dy += math.Sin(phase) * richTextWaveHeight * float64(spanH)
+		}
+		if span.shake {
+			step := int(seconds * richTextShakeRate)
+			distance := richTextShakeDistance * float64(spanH)
+			dx += (shakeNoise(step, index, 0)*2 - 1) * distance
+			dy += (shakeNoise(step, index, 1)*2 - 1) * distance
+		}
+		drawStyled(
+			string(r),
+			spanX+runeX+int(math.Round(dx)),
+			spanY+int(math.Round(dy)),
+		)
+		index++
+	}
+}
+
+// shakeNoise returns a pseudo random number in [0..1) that only depends on
+// its inputs, so a shaking character stays put between shake steps.
+func shakeNoise(step, index, axis int) float64 {
+	h := uint32(step)*73856093 ^ uint32(index)*19349663 ^ uint32(axis)*83492791
+	h ^= h >> 13
+	h *= 0x5bd1e995
+	h ^= h >> 15
+	return float64(h%1000) / 1000
+}
@@ -0,0 +1,167 @@
+package draw
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// scaledMonoMeasure measures text like the built-in font does, with 10 pixels
+// per character and 16.75 per line at scale 1.
+func scaledMonoMeasure(text string, scale float32) (w, h int) {
+	lines := strings.Split(text, "\n")
+	maxChars := 0
+	for _, line := range lines {
+		if n := utf8.RuneCountInString(line); n > maxChars {
+			maxChars = n
+		}
+	}
+	return int(10*float32(maxChars)*scale + 0.5), int(16.75*float32(len(lines))*scale + 0.5)
+}
+
+func TestRichTextMarkupIsParsedIntoSpans(t *testing.T) {
+	lines := parseRichText(
+		"Press [color=yellow]E[/color] to [b]open[/b]\n[scale=2][wave]big[/wave][/scale]",
+		richStyle{color: White, scale: 1},
+	)
+	want := []richLine{
+		{
+			scale: 1,
+			spans: []richSpan{
+				{"Press ", richStyle{color: White, scale: 1}},
+				{"E", richStyle{color: Yellow, scale: 1}},
+				{" to ", richStyle{color: White, scale: 1}},
+				{"open", richStyle{color: White, scale: 1, bold: true}},
+			},
+		},
+		{
+			scale: 2,
+			spans: []richSpan{
+				{"big", richStyle{color: White, scale: 2, wave: true}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(lines, want) {
+		t.Errorf("want\n%+v\nbut have\n%+v", want, lines)
+	}
+}
+
+func TestRichTextTagsNest(t *testing.T) {
+	lines := parseRichText(
+		"[color=red]a[color=#0000FF]b[/color]c[/color]d",
+		richStyle{color: White, scale: 1},
+	)
+	var colors []Color
+	for _, span := range lines[0].spans {
+		colors = append(colors, span.color)
+	}
+	want := []Color{Red, Blue, Red, White}
+	if !reflect.DeepEqual(colors, want) {
+		t.Errorf("want colors %v but have %v", want, colors)
+	}
+}
+
+func TestUnknownRichTextTagsAreText(t *testing.T) {
+	checkRichTextPlain(t, "[x] [[b] [color=nope] [scale=-1] a[b", "[x] [b] [color=nope] [scale=-1] a[b")
+	checkRichTextPlain(t, "[/b]a[/color]", "a")
+}
+
+func TestHexColorsWithAlphaAreParsed(t *testing.T) {
+	c, ok := parseRichTextColor("#FF000080")
+	if !ok || c != RGBA(1, 0, 0, 128.0/255) {
+		t.Errorf("want half transparent red but have %v, %v", c, ok)
+	}
+	if _, ok := parseRichTextColor("#12345"); ok {
+		t.Error("a 5 digit color should not be valid")
+	}
+}
+
+func TestPlainRichTextHasSameSizeAsText(t *testing.T) {
+	for _, text := range []string{"", "a", "abc\nd", "a\nb\nc\n", "\n\n\n\n"} {
+		for _, scale := range []float32{1, 1.3, 2.7} {
+			wantW, wantH := scaledMonoMeasure(text, scale)
+			_, w, h := layoutRichText(text, scale, White, scaledMonoMeasure)
+			if w != wantW || h != wantH {
+				t.Errorf("%q at %v: want %dx%d but have %dx%d", text, scale, wantW, wantH, w, h)
+			}
+		}
+	}
+}
+
+func TestRichTextSpansArePlacedNextToEachOther(t *testing.T) {
+	spans, w, h := layoutRichText("ab[scale=2]cd[/scale]e\nf", 1, White, scaledMonoMeasure)
+	var positions [][3]int
+	for _, s := range spans {
+		positions = append(positions, [3]int{s.x, s.y, s.index})
+	}
+	want := [][3]int{
+		{0, 17, 0},  // "ab" at the bottom of the scale 2 line
+		{20, 0, 2},  // "cd"
+		{60, 17, 4}, // "e"
+		{0, 34, 5},  // "f" on the next line
+	}
+	if !reflect.DeepEqual(positions, want) {
+		t.Errorf("want span positions %v but have %v", want, positions)
+	}
+	if w != 70 || h != 34+17 {
+		t.Errorf("want size 70x51 but have %dx%d", w, h)
+	}
+}
+
+func TestRichTextIsKernedAcrossSpans(t *testing.T) {
+	// kernedMeasure is like scaledMonoMeasure but moves A and V 3 pixels
+	// closer together at scale 1.
+	kernedMeasure := func(text string, scale float32) (int, int) {
+		w, h := scaledMonoMeasure(text, scale)
+		return w - int(3*float32(strings.Count(text, "AV"))*scale+0.5), h
+	}
+
+	spans, w, _ := layoutRichText("xA[color=red]V[/color]", 1, White, kernedMeasure)
+	if wantW, _ := kernedMeasure("xAV", 1); w != wantW {
+		t.Errorf("want width %d but have %d", wantW, w)
+	}
+	if spans[1].x != 17 {
+		t.Errorf("want V kerned to 17 but have %d", spans[1].x)
+	}
+
+	// Spans with different scales are not kerned.
+	spans, w, _ = layoutRichText("A[scale=2]V[/scale]", 1, White, kernedMeasure)
+	if spans[1].x != 10 || w != 30 {
+		t.Errorf("want V at 10 and width 30 but have %d and %d", spans[1].x, w)
+	}
+}
+
+func TestRichTextIsDrawnInSpans(t *testing.T) {
+	type call struct {
+		text  string
+		x, y  int
+		color Color
+	}
+	var calls []call
+	drawRichText("a[color=red]bc[/color]", 5, 7, 1, White, scaledMonoMeasure,
+		textEffects{},
+		func(text string, x, y int, scale float32, color Color) {
+			calls = append(calls, call{text, x, y, color})
+		},
+		nil,
+	)
+	want := []call{{"a", 5, 7, White}, {"bc", 15, 7, Red}}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("want %v but have %v", want, calls)
+	}
+}
+
+func checkRichTextPlain(t *testing.T, markup, want string) {
+	t.Helper()
+	var text string
+	for _, span := range parseRichText(markup, richStyle{color: White, scale: 1})[0].spans {
+		if span.color != White || span.bold {
+			t.Errorf("%q: span %q should not be styled", markup, span.text)
+		}
+		text += span.text
+	}
+	if text != want {
+		t.Errorf("%q: want text %q but have %q", markup, want, text)
+	}
+}
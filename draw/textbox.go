@@ -0,0 +1,155 @@
+package draw
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// TextAlign is the position of text inside the box of Window.DrawTextBox.
+// Combine a horizontal and a vertical alignment with |, e.g.
+// AlignCenter|AlignMiddle. The default is AlignLeft|AlignTop.
+type TextAlign int
+
+const (
+	AlignLeft   TextAlign = 0
+	AlignCenter TextAlign = 1
+	AlignRight  TextAlign = 2
+
+	AlignTop    TextAlign = 0
+	AlignMiddle TextAlign = 4
+	AlignBottom TextAlign = 8
+)
+
+// textBoxEllipsis is appended to the last visible line of a text box if the
+// text does not fit.
+const textBoxEllipsis = "..."
+
+// textMeasure returns the size of the text, like Window.GetTextSize.
+type textMeasure func(text string) (width, height int)
+
+// textBoxLine is a line of text at its position relative to the text box.
+type textBoxLine struct {
+	text string
+	x, y int
+}
+
+// wrapText breaks the text into lines that are at most width wide. It breaks
+// at spaces where it can and inside words that are too long for a line of
+// their own. Line breaks in the text are kept.
+func wrapText(text string, width int, measure textMeasure) []string {
+	if width <= 0 {
+		return nil
+	}
+
+	fits := func(s string) bool {
+		w, _ := measure(s)
+		return w <= width
+	}
+
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+		for i, word := range strings.Split(paragraph, " ") {
+			if i > 0 {
+				if fits(line + " " + word) {
+					line += " " + word
+					continue
+				}
+				// The space at the line break is dropped.
+				lines = append(lines, line)
+			}
+			for !fits(word) && utf8.RuneCountInString(word) > 1 {
+				n := fittingPrefix(word, fits)
+				lines = append(lines, word[:n])
+				word = word[n:]
+			}
+			line = word
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// fittingPrefix returns the length in bytes of the longest prefix of s that
+// fits. The prefix has at least one rune, even if that does not fit.
+func fittingPrefix(s string, fits func(string) bool) int {
+	_, n := utf8.DecodeRuneInString(s)
+	for n < len(s) {
+		_, size := utf8.DecodeRuneInString(s[n:])
+		if !fits(s[:n+size]) {
+			break
+		}
+		n += size
+	}
+	return n
+}
+
+// layoutTextBox wraps the text to the box's width and aligns the lines in the
+// box. Only lines that fit completely into the box's height are returned. If
+// some do not fit, the last visible line ends in an ellipsis.
+func layoutTextBox(
+	text string,
+	width, height int,
+	align TextAlign,
+	measure textMeasure,
+) []textBoxLine {
+	lines := wrapText(text, width, measure)
+	_, lineHeight := measure("")
+	if len(lines) == 0 || lineHeight <= 0 {
+		return nil
+	}
+
+	visible := height / lineHeight
+	if visible < len(lines) {
+		lines = lines[:visible]
+		if visible > 0 {
+			lines[visible-1] = withEllipsis(lines[visible-1], width, measure)
+		}
+	}
+
+	top := 0
+	if align&AlignMiddle != 0 {
+		top = (height - len(lines)*lineHeight) / 2
+	} else if align&AlignBottom != 0 {
+		top = height - len(lines)*lineHeight
+	}
+
+	layout := make([]textBoxLine, len(lines))
+	for i, line := range lines {
+		lineW, _ := measure(line)
+		left := 0
+		if align&AlignCenter != 0 {
+			left = (width - lineW) / 2
+		} else if align&AlignRight != 0 {
+			left = width - lineW
+		}
+		layout[i] = textBoxLine{text: line, x: left, y: top + i*lineHeight}
+	}
+	return layout
+}
+
+// withEllipsis appends the ellipsis to the line, removing as many characters
+// from its end as necessary to make it fit the width.
+func withEllipsis(line string, width int, measure textMeasure) string {
+	line = strings.TrimRight(line, " ")
+	for {
+		if w, _ := measure(line + textBoxEllipsis); w <= width || line == "" {
+			return line + textBoxEllipsis
+		}
+		_, size := utf8.DecodeLastRuneInString(line)
+		line = strings.TrimRight(line[:len(line)-size], " ")
+	}
+}
+
+// textBoxSize returns the size of the text when wrapped to the width, with all
+// of its lines.
+func textBoxSize(text string, width int, measure textMeasure) (w, h int) {
+	lines := wrapText(text, width, measure)
+	_, lineHeight := measure("")
+	for _, line := range lines {
+		if lineW, _ := measure(line); lineW > w {
+			w = lineW
+		}
+	}
+	return w, len(lines) * lineHeight
+}
@@ -0,0 +1,93 @@
+package draw
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// monoMeasure measures text as 10 pixels per character and 20 per line.
+func monoMeasure(text string) (w, h int) {
+	lines := strings.Split(text, "\n")
+	for _, line := range lines {
+		if lineW := 10 * utf8.RuneCountInString(line); lineW > w {
+			w = lineW
+		}
+	}
+	return w, 20 * len(lines)
+}
+
+func TestTextIsWrappedAtSpaces(t *testing.T) {
+	checkWrap(t, "", 50, "")
+	checkWrap(t, "abc", 50, "abc")
+	checkWrap(t, "abc de", 60, "abc de")
+	checkWrap(t, "abc def gh", 70, "abc def", "gh")
+	checkWrap(t, "ab cd ef gh", 50, "ab cd", "ef gh")
+	checkWrap(t, "one\ntwo three", 50, "one", "two", "three")
+	checkWrap(t, "ab  cd", 40, "ab ", "cd")
+}
+
+func TestLongWordsAreBrokenAnywhere(t *testing.T) {
+	checkWrap(t, "abcdefg", 30, "abc", "def", "g")
+	checkWrap(t, "a bcdefg h", 30, "a", "bcd", "efg", "h")
+	checkWrap(t, "äöü", 10, "ä", "ö", "ü")
+}
+
+func TestNothingIsWrappedIntoNoWidth(t *testing.T) {
+	if lines := wrapText("text", 0, monoMeasure); len(lines) != 0 {
+		t.Errorf("want no lines but have %q", lines)
+	}
+}
+
+func TestTextBoxLinesAreAligned(t *testing.T) {
+	checkTextBox(t, "ab cd\nefgh", 60, 60, AlignLeft|AlignTop,
+		textBoxLine{"ab cd", 0, 0},
+		textBoxLine{"efgh", 0, 20},
+	)
+	checkTextBox(t, "ab cd\nefgh", 60, 60, AlignCenter|AlignMiddle,
+		textBoxLine{"ab cd", 5, 10},
+		textBoxLine{"efgh", 10, 30},
+	)
+	checkTextBox(t, "ab cd\nefgh", 60, 60, AlignRight|AlignBottom,
+		textBoxLine{"ab cd", 10, 20},
+		textBoxLine{"efgh", 20, 40},
+	)
+}
+
+func TestCutOffTextEndsInEllipsis(t *testing.T) {
+	checkTextBox(t, "one two three four", 70, 45, AlignLeft,
+		textBoxLine{"one two", 0, 0},
+		textBoxLine{"thre...", 0, 20},
+	)
+	checkTextBox(t, "a b\nc", 60, 20, AlignLeft,
+		textBoxLine{"a b...", 0, 0},
+	)
+	checkTextBox(t, "abc", 30, 10, AlignLeft)
+}
+
+func TestTextBoxSizeHasAllLines(t *testing.T) {
+	w, h := textBoxSize("one two three four", 70, monoMeasure)
+	if w != 70 || h != 60 {
+		t.Errorf("want 70x60 but have %dx%d", w, h)
+	}
+}
+
+func checkWrap(t *testing.T, text string, width int, want ...string) {
+	t.Helper()
+	have := wrapText(text, width, monoMeasure)
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("%q wrapped to %d: want %q but have %q", text, width, want, have)
+	}
+}
+
+func checkTextBox(t *testing.T, text string, width, height int, align TextAlign, want ...textBoxLine) {
+	t.Helper()
+	have := layoutTextBox(text, width, height, align, monoMeasure)
+	if len(have) == 0 && len(want) == 0 {
+		return
+	}
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("%q in %dx%d box: want\n%v\nbut have\n%v", text, width, height, want, have)
+	}
+}
@@ -0,0 +1,345 @@
+package draw
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	"image/png"
+	"math"
+)
+
+// textEffects are the outline and shadow that text is drawn with, see
+// Window.SetTextOutline and Window.SetTextShadow. All sizes are for text at
+// scale 1 and scale with the text.
+type textEffects struct {
+	outlineWidth     int
+	outlineColor     Color
+	shadowX, shadowY int
+	shadowColor      Color
+}
+
+// textLayer is one pass of drawing text with effects.
+type textLayer struct {
+	// dx and dy offset the layer, at scale 1.
+	dx, dy int
+	// outline is the outline width to draw or 0 to draw the text itself.
+	outline int
+	// color is the layer's color, unless textColor is true, in which case the
+	// text is drawn in its own color.
+	color     Color
+	textColor bool
+}
+
+// layers returns the passes for drawing text, back to front: the shadow, the
+// outline and the text itself.
+func (e textEffects) layers() []textLayer {
+	var layers []textLayer
+	if (e.shadowX != 0 || e.shadowY != 0) && e.shadowColor.A > 0 {
+		// The shadow has the shape of the outline, if there is one.
+		layers = append(layers, textLayer{
+			dx:      e.shadowX,
+			dy:      e.shadowY,
+			outline: e.outlineWidth,
+			color:   e.shadowColor,
+		})
+	}
+	if e.outlineWidth > 0 && e.outlineColor.A > 0 {
+		layers = append(layers, textLayer{
+			outline: e.outlineWidth,
+			color:   e.outlineColor,
+		})
+	}
+	return append(layers, textLayer{textColor: true})
+}
+
+// textDrawer draws plain text like Window.DrawScaledText.
+type textDrawer func(text string, x, y int, scale float32, color Color)
+
+// outlineDrawer draws the outline of the text, with the given width at scale
+// 1, in a solid color.
+type outlineDrawer func(text string, x, y int, scale float32, width int, color Color)
+
+// draw draws the layer of the text at x,y, with color being the text's color.
+func (l textLayer) draw(
+	text string,
+	x, y int,
+	scale float32,
+	color Color,
+	drawText textDrawer,
+	drawOutline outlineDrawer,
+) {
+	if !l.textColor {
+		color = l.color
+	}
+	x += int(math.Round(float64(float32(l.dx) * scale)))
+	y += int(math.Round(float64(float32(l.dy) * scale)))
+	if l.outline > 0 {
+		drawOutline(text, x, y, scale, l.outline, color)
+	} else {
+		drawText(text, x, y, scale, color)
+	}
+}
+
+// draw draws the text with its shadow and outline.
+func (e textEffects) draw(
+	text string,
+	x, y int,
+	scale float32,
+	color Color,
+	drawText textDrawer,
+	drawOutline outlineDrawer,
+) {
+	for _, layer := range e.layers() {
+		layer.draw(text, x, y, scale, color, drawText, drawOutline)
+	}
+}
+
+// glyphOutlines are glyphs that are grown by a radius on all sides, to be
+// drawn behind the glyphs as their outlines. They are created from the glyphs
+// of another atlas as needed.
+type glyphOutlines struct {
+	glyphAtlas
+	radius int
+	// glyphs are the outlines by the position of their glyph in the source
+	// atlas.
+	glyphs map[image.Point]glyph
+}
+
+func newGlyphOutlines(radius, glyphHeight int) *glyphOutlines {
+	return &glyphOutlines{
+		glyphAtlas: newGlyphAtlas(glyphHeight + 2*radius),
+		radius:     radius,
+		glyphs:     make(map[image.Point]glyph),
+	}
+}
+
+// outline returns the outline of glyph g from the src atlas, creating it if
+// necessary. The outline's offset is moved by the radius so it can be laid out
+// just like the glyph.
+func (o *glyphOutlines) outline(src *image.NRGBA, g glyph) glyph {
+	key := image.Pt(g.x, g.y)
+	if outline, ok := o.glyphs[key]; ok {
+		return outline
+	}
+
+	r := o.radius
+	outline := glyph{
+		width:   g.width + 2*r,
+		height:  g.height + 2*r,
+		offsetX: g.offsetX - r,
+		offsetY: g.offsetY - r,
+		advance: g.advance,
+	}
+	outline.x, outline.y = o.place(outline.width, outline.height)
+
+	inside := make([]bool, outline.width*outline.height)
+	for y := 0; y < g.height; y++ {
+		for x := 0; x < g.width; x++ {
+			a := src.Pix[src.PixOffset(g.x+x, g.y+y)+3]
+			inside[(y+r)*outline.width+x+r] = a >= 128
+		}
+	}
+	dist := squaredDistances(inside, outline.width, outline.height)
+
+	for y := 0; y < outline.height; y++ {
+		for x := 0; x < outline.width; x++ {
+			// Pixels up to the radius are covered, the outline fades out over
+			// the next pixel for a smooth edge.
+			d := math.Sqrt(dist[y*outline.width+x])
+			a := math.Max(0, math.Min(1, float64(r)+1-d))
+			alpha := uint8(a*255 + 0.5)
+			srcX, srcY := x-r, y-r
+			if 0 <= srcX && srcX < g.width && 0 <= srcY && srcY < g.height {
+				if srcA := src.Pix[src.PixOffset(g.x+srcX, g.y+srcY)+3]; srcA > alpha {
+					alpha = srcA
+				}
+			}
+			i := o.atlas.PixOffset(outline.x+x, outline.y+y)
+			o.atlas.Pix[i+0] = 255
+			o.atlas.Pix[i+1] = 255
+			o.atlas.Pix[i+2] = 255
+			o.atlas.Pix[i+3] = alpha
+		}
+	}
+	o.version++
+
+	o.glyphs[key] = outline
+	return outline
+}
+
+// squaredDistances returns, for each pixel of the width x height grid, the
+// squared Euclidean distance to the nearest pixel that is inside. It uses the
+// linear time distance transform by Felzenszwalb and Huttenlocher.
+func squaredDistances(inside []bool, width, height int) []float64 {
+	const far = 1e20
+	dist := make([]float64, len(inside))
+	for i, in := range inside {
+		if !in {
+			dist[i] = far
+		}
+	}
+
+	n := width
+	if height > n {
+		n = height
+	}
+	f := make([]float64, n)
+	d := make([]float64, n)
+	v := make([]int, n)
+	z := make([]float64, n+1)
+
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			f[y] = dist[y*width+x]
+		}
+		distanceTransform1D(f[:height], d, v, z)
+		for y := 0; y < height; y++ {
+			dist[y*width+x] = d[y]
+		}
+	}
+	for y := 0; y < height; y++ {
+		copy(f, dist[y*width:(y+1)*width])
+		distanceTransform1D(f[:width], d, v, z)
+		copy(dist[y*width:(y+1)*width], d[:width])
+	}
+	return dist
+}
+
+// distanceTransform1D computes the squared distance transform of f into d. v
+// and z are scratch space of at least len(f) and len(f)+1 elements.
+func distanceTransform1D(f, d []float64, v []int, z []float64) {
+	n := len(f)
+	k := 0
+	v[0] = 0
+	z[0] = math.Inf(-1)
+	z[1] = math.Inf(1)
+	for q := 1; q < n; q++ {
+		s := ((f[q] + float64(q*q)) - (f[v[k]] + float64(v[k]*v[k]))) / float64(2*q-2*v[k])
+		for s <= z[k] {
+			k--
+			s = ((f[q] + float64(q*q)) - (f[v[k]] + float64(v[k]*v[k]))) / float64(2*q-2*v[k])
+		}
+		k++
+		v[k] = q
+		z[k] = s
+		z[k+1] = math.Inf(1)
+	}
+	k = 0
+	for q := 0; q < n; q++ {
+		for z[k+1] < float64(q) {
+			k++
+		}
+		dq := float64(q - v[k])
+		d[q] = dq*dq + f[v[k]]
+	}
+}
+
+// outlinesFor returns the font's glyph outlines for the given radius, in pixels
+// of the font's atlas.
+func (f *Font) outlinesFor(radius int) *glyphOutlines {
+	if f.outlines == nil {
+		f.outlines = make(map[int]*glyphOutlines)
+	}
+	o, ok := f.outlines[radius]
+	if !ok {
+		o = newGlyphOutlines(radius, f.lineHeight)
+		f.outlines[radius] = o
+	}
+	return o
+}
+
+// glyphQuad is a glyph from an atlas and where to draw it.
+type glyphQuad struct {
+	g                   glyph
+	x, y, width, height float32
+}
+
+// outlineQuads returns the outline glyphs for the text, laid out like
+// Font.layout does. The outlines are width pixels wide at scale 1.
+func (f *Font) outlineQuads(text string, x, y, scale float32, width int) (*glyphOutlines, []glyphQuad) {
+	outlines := f.outlinesFor(width)
+	var quads []glyphQuad
+	f.layout(text, x, y, scale, func(g glyph, x, y, w, h float32) {
+		outline := outlines.outline(f.atlas, g)
+		r := float32(outlines.radius) * scale
+		quads = append(quads, glyphQuad{outline, x - r, y - r, w + 2*r, h + 2*r})
+	})
+	return outlines, quads
+}
+
+var (
+	// bitmapFontImage is the decoded font bitmap that outlines for the
+	// built-in font are made from.
+	bitmapFontImage *image.NRGBA
+	// bitmapFontOutlines are the outlines of the glyphs in the font bitmap,
+	// by their radius in bitmap pixels.
+	bitmapFontOutlines = make(map[int]*glyphOutlines)
+)
+
+// builtinOutlineQuads returns the outline glyphs for text in the built-in
+// font, laid out like layoutBuiltinText does. The outlines are width pixels
+// wide at scale 1. It returns the outlines of glyphs from the font bitmap and
+// from the fallback font separately.
+func builtinOutlineQuads(
+	fallback *Font,
+	text string,
+	x, y, scale float32,
+	width int,
+) (
+	bitmapOutlines *glyphOutlines,
+	bitmapQuads []glyphQuad,
+	fallbackOutlines *glyphOutlines,
+	fallbackQuads []glyphQuad,
+) {
+	if bitmapFontImage == nil {
+		img, err := png.Decode(bytes.NewReader(bitmapFontWhitePng))
+		if err != nil {
+			panic("decoding the embedded font bitmap: " + err.Error())
+		}
+		bitmapFontImage = image.NewNRGBA(image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy()))
+		draw.Draw(bitmapFontImage, bitmapFontImage.Rect, img, img.Bounds().Min, draw.Src)
+	}
+	charW := bitmapFontImage.Rect.Dx() / 16
+	charH := bitmapFontImage.Rect.Dy() / 16
+	innerW := charW - 2*fontGlyphMargin
+	innerH := charH - 2*fontGlyphMargin
+
+	// A line of text at scale 1 is as high as a glyph in the bitmap.
+	radius := int(float32(width*innerH)/builtinLineHeight + 0.5)
+	bitmapOutlines = bitmapFontOutlines[radius]
+	if bitmapOutlines == nil {
+		bitmapOutlines = newGlyphOutlines(radius, innerH)
+		bitmapFontOutlines[radius] = bitmapOutlines
+	}
+	if fallback != nil {
+		fallbackOutlines = fallback.outlinesFor(width * builtinFallbackResolution)
+	}
+
+	// The cells are not quite the same shape as the glyphs in the bitmap.
+	cellW, cellH := builtinCellSize(scale)
+	rx := float32(radius) * cellW / float32(innerW)
+	ry := float32(radius) * cellH / float32(innerH)
+
+	layoutBuiltinText(fallback, text, x, y, scale,
+		func(index rune, x, y float32) {
+			g := glyph{
+				x:      int(index%16)*charW + fontGlyphMargin,
+				y:      int(index/16)*charH + fontGlyphMargin,
+				width:  innerW,
+				height: innerH,
+			}
+			outline := bitmapOutlines.outline(bitmapFontImage, g)
+			bitmapQuads = append(bitmapQuads, glyphQuad{
+				outline, x - rx, y - ry, cellW + 2*rx, cellH + 2*ry,
+			})
+		},
+		func(g glyph, x, y, w, h float32) {
+			outline := fallbackOutlines.outline(fallback.atlas, g)
+			r := float32(fallbackOutlines.radius) * w / float32(g.width)
+			fallbackQuads = append(fallbackQuads, glyphQuad{
+				outline, x - r, y - r, w + 2*r, h + 2*r,
+			})
+		},
+	)
+	return
+}
@@ -0,0 +1,100 @@
+package draw
+
+import (
+	"image"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSquaredDistancesAreEuclidean(t *testing.T) {
+	const size = 7
+	inside := make([]bool, size*size)
+	inside[3*size+3] = true
+	dist := squaredDistances(inside, size, size)
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			want := float64((x-3)*(x-3) + (y-3)*(y-3))
+			if have := dist[y*size+x]; have != want {
+				t.Errorf("%d,%d: want %v but have %v", x, y, want, have)
+			}
+		}
+	}
+}
+
+func TestGlyphOutlineGrowsGlyphByRadius(t *testing.T) {
+	// The source glyph is a single opaque pixel at 5,6 in the atlas.
+	src := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+	src.Pix[src.PixOffset(5, 6)+3] = 255
+	g := glyph{x: 5, y: 6, width: 1, height: 1, offsetX: 2, offsetY: -3, advance: 4}
+
+	o := newGlyphOutlines(2, 1)
+	outline := o.outline(src, g)
+	if outline.width != 5 || outline.height != 5 ||
+		outline.offsetX != 0 || outline.offsetY != -5 || outline.advance != 4 {
+		t.Fatalf("wrong outline metrics %+v", outline)
+	}
+	if o.version == 0 {
+		t.Error("the atlas version should change")
+	}
+
+	alpha := func(x, y int) uint8 {
+		return o.atlas.Pix[o.atlas.PixOffset(outline.x+x, outline.y+y)+3]
+	}
+	// The outline is a disc around the pixel with a soft edge.
+	if alpha(2, 2) != 255 || alpha(0, 2) != 255 || alpha(2, 4) != 255 {
+		t.Error("the outline should cover the radius")
+	}
+	if a := alpha(0, 0); a >= alpha(0, 1) {
+		t.Errorf("the corners are farther out and should be fainter but have alpha %d", a)
+	}
+	want := uint8(math.Round((3 - math.Sqrt(5)) * 255))
+	if a := alpha(0, 1); a != want {
+		t.Errorf("want soft edge alpha %d but have %d", want, a)
+	}
+
+	if again := o.outline(src, g); again != outline || o.version != 1 {
+		t.Error("an outline should only be created once")
+	}
+}
+
+func TestTextEffectsDrawShadowThenOutlineThenText(t *testing.T) {
+	type call struct {
+		x, y, outline int
+		color         Color
+	}
+	var calls []call
+	drawText := func(text string, x, y int, scale float32, color Color) {
+		calls = append(calls, call{x, y, 0, color})
+	}
+	drawOutline := func(text string, x, y int, scale float32, width int, color Color) {
+		calls = append(calls, call{x, y, width, color})
+	}
+
+	textEffects{}.draw("a", 10, 20, 2, White, drawText, drawOutline)
+	if want := []call{{10, 20, 0, White}}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("without effects: want %v but have %v", want, calls)
+	}
+
+	calls = nil
+	effects := textEffects{
+		outlineWidth: 1,
+		outlineColor: Black,
+		shadowX:      2,
+		shadowY:      3,
+		shadowColor:  Gray,
+	}
+	effects.draw("a", 10, 20, 2, White, drawText, drawOutline)
+	want := []call{{14, 26, 1, Gray}, {10, 20, 1, Black}, {10, 20, 0, White}}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("with effects: want %v but have %v", want, calls)
+	}
+
+	calls = nil
+	effects.outlineWidth = 0
+	effects.draw("a", 10, 20, 1, White, drawText, drawOutline)
+	want = []call{{12, 23, 0, Gray}, {10, 20, 0, White}}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("shadow only: want %v but have %v", want, calls)
+	}
+}
@@ -0,0 +1,45 @@
+package draw
+
+import "math"
+
+// textTransform places text that is drawn at the origin with scale 1 on the
+// screen, see Window.DrawTransformedText.
+type textTransform struct {
+	// x and y are where the anchor ends up on the screen.
+	x, y float32
+	// anchorX and anchorY are the anchor point in the unscaled text.
+	anchorX, anchorY float32
+	scaleX, scaleY   float32
+	rotationCWDeg    int
+	sin, cos         float32
+}
+
+// newTextTransform creates the transform for text of the given unscaled size.
+// The anchor is relative to that size.
+func newTextTransform(
+	x, y int,
+	width, height int,
+	anchorX, anchorY float32,
+	scaleX, scaleY float32,
+	rotationCWDeg int,
+) textTransform {
+	sin, cos := math.Sincos(float64(rotationCWDeg) / 180 * math.Pi)
+	return textTransform{
+		x:             float32(x),
+		y:             float32(y),
+		anchorX:       anchorX * float32(width),
+		anchorY:       anchorY * float32(height),
+		scaleX:        scaleX,
+		scaleY:        scaleY,
+		rotationCWDeg: rotationCWDeg,
+		sin:           float32(sin),
+		cos:           float32(cos),
+	}
+}
+
+// apply maps the point in the untransformed text to the screen.
+func (t textTransform) apply(x, y float32) (float32, float32) {
+	x = (x - t.anchorX) * t.scaleX
+	y = (y - t.anchorY) * t.scaleY
+	return t.x + t.cos*x - t.sin*y, t.y + t.sin*x + t.cos*y
+}
@@ -0,0 +1,39 @@
+package draw
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTextTransformRotatesAroundAnchor(t *testing.T) {
+	// Text of size 40x10, anchored at its center, which goes to 100,200.
+	tf := newTextTransform(100, 200, 40, 10, 0.5, 0.5, 1, 1, 0)
+	checkTransformedPoint(t, tf, 20, 5, 100, 200)
+	checkTransformedPoint(t, tf, 0, 0, 80, 195)
+
+	// Rotating clockwise by 90° turns the right end of the text down.
+	tf = newTextTransform(100, 200, 40, 10, 0.5, 0.5, 1, 1, 90)
+	checkTransformedPoint(t, tf, 20, 5, 100, 200)
+	checkTransformedPoint(t, tf, 40, 5, 100, 220)
+	checkTransformedPoint(t, tf, 20, 0, 105, 200)
+}
+
+func TestTextTransformScalesAwayFromAnchor(t *testing.T) {
+	tf := newTextTransform(10, 20, 40, 10, 0, 1, 2, -3, 0)
+	checkTransformedPoint(t, tf, 0, 10, 10, 20)
+	checkTransformedPoint(t, tf, 40, 0, 90, 50)
+}
+
+func TestUntransformedTextIsDrawnLikeDrawText(t *testing.T) {
+	tf := newTextTransform(10, 20, 40, 10, 0, 0, 1, 1, 0)
+	checkTransformedPoint(t, tf, 0, 0, 10, 20)
+	checkTransformedPoint(t, tf, 40, 10, 50, 30)
+}
+
+func checkTransformedPoint(t *testing.T, tf textTransform, x, y, wantX, wantY float32) {
+	t.Helper()
+	haveX, haveY := tf.apply(x, y)
+	if math.Abs(float64(haveX-wantX)) > 1e-4 || math.Abs(float64(haveY-wantY)) > 1e-4 {
+		t.Errorf("%v,%v: want %v,%v but have %v,%v", x, y, wantX, wantY, haveX, haveY)
+	}
+}
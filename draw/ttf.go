@@ -0,0 +1,369 @@
+package draw
+
+import (
+	"errors"
+	"image"
+	"image/draw"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// Font is a TrueType or OpenType font at a fixed size in pixels. Create it
+// with LoadFont or ParseFont and use it for drawing text with Window.SetFont.
+// LoadBitmapFont and LoadBMFont create a Font from a pre-rendered bitmap font.
+//
+// The glyphs are rasterized the first time they are drawn and stored in a
+// texture atlas that grows as needed. After that, drawing text is as fast as
+// with the built-in font.
+type Font struct {
+	// faces are the font itself, followed by its fallbacks.
+	faces      []fontFace
+	buf        sfnt.Buffer
+	size       float32
+	ascent     int
+	lineHeight int
+	glyphs     map[rune]glyph
+	// kerning is the extra space between pairs of runes. Bitmap fonts have all
+	// of their pairs in it, for TrueType fonts it caches the pairs used so
+	// far.
+	kerning map[[2]rune]float32
+	// missing are the runes that none of the faces have. They are drawn as a
+	// question mark until a fallback with them is added.
+	missing map[rune]bool
+	glyphAtlas
+	// outlines are the font's glyph outlines by their radius.
+	outlines map[int]*glyphOutlines
+}
+
+// glyphAtlas is an image that glyphs are packed into, to be used as a texture
+// in the backends.
+type glyphAtlas struct {
+	atlas *image.NRGBA
+	// The atlas is filled row by row. penX, penY is where the next glyph goes
+	// and rowHeight is the height of the tallest glyph in the current row.
+	penX, penY, rowHeight int
+	// version is increased whenever the atlas changes so the backends know to
+	// upload it again.
+	version int
+	// key identifies the atlas texture in the backends.
+	key string
+}
+
+type fontFace struct {
+	font *sfnt.Font
+	face font.Face
+}
+
+// glyph is a rasterized character in the font atlas.
+type glyph struct {
+	// x, y, width and height are the glyph's rectangle in the atlas.
+	x, y, width, height int
+	// offsetX and offsetY are the position of the glyph's top-left corner,
+	// relative to the pen position on the baseline.
+	offsetX, offsetY int
+	advance          float32
+}
+
+const (
+	// fontAtlasPadding is the space around each glyph in the atlas. It keeps
+	// neighbors from bleeding into each other when the text is scaled.
+	fontAtlasPadding = 2
+
+	minFontAtlasSize = 64
+)
+
+var fontCount int32
+
+// newFontKey returns a unique key for a new font's atlas texture.
+func newFontKey() string {
+	return "///font/" + strconv.Itoa(int(atomic.AddInt32(&fontCount, 1)))
+}
+
+// LoadFont reads a TrueType (.ttf) or OpenType (.otf) font file through
+// ReadFile, to draw text at the given size in pixels. On WASM, call it before
+// RunWindow or from a goroutine, just like ReadFile.
+func LoadFont(path string, size float32) (*Font, error) {
+	data, err := ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFont(data, size)
+}
+
+// ParseFont is like LoadFont but takes the contents of the font file, e.g. from
+// an embedded file.
+func ParseFont(data []byte, size float32) (*Font, error) {
+	if size <= 0 {
+		return nil, errors.New("font size must be positive but is " +
+			strconv.FormatFloat(float64(size), 'g', -1, 32))
+	}
+
+	face, err := parseFontFace(data, size)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := face.face.Metrics()
+	f := &Font{
+		faces:      []fontFace{face},
+		size:       size,
+		ascent:     metrics.Ascent.Ceil(),
+		lineHeight: metrics.Height.Ceil(),
+		glyphs:     make(map[rune]glyph),
+		missing:    make(map[rune]bool),
+		glyphAtlas: newGlyphAtlas(metrics.Height.Ceil()),
+	}
+	return f, nil
+}
+
+// newGlyphAtlas creates an atlas for glyphs of about the given height. It is
+// wide enough for a few lines of text and grows as needed.
+func newGlyphAtlas(glyphHeight int) glyphAtlas {
+	width, height := 4*minFontAtlasSize, minFontAtlasSize
+	for width < 8*glyphHeight {
+		width *= 2
+	}
+	for height < 2*glyphHeight {
+		height *= 2
+	}
+	return glyphAtlas{
+		atlas: image.NewNRGBA(image.Rect(0, 0, width, height)),
+		key:   newFontKey(),
+	}
+}
+
+func parseFontFace(data []byte, size float32) (fontFace, error) {
+	parsed, err := opentype.Parse(data)
+	if err != nil {
+		return fontFace{}, errors.New("parsing font: " + err.Error())
+	}
+
+	face, err := opentype.NewFace(parsed, &opentype.FaceOptions{
+		Size:    float64(size),
+		DPI:     72, // at 72 DPI, one point is one pixel
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return fontFace{}, errors.New("creating font face: " + err.Error())
+	}
+
+	return fontFace{font: parsed, face: face}, nil
+}
+
+// LoadFallback reads a TrueType or OpenType font file through ReadFile and
+// uses it for all characters that the font does not have. Fallbacks are
+// searched in the order that they were added. Use this e.g. to add a font with
+// Chinese, Japanese or Korean characters to a font that has only Latin ones.
+func (f *Font) LoadFallback(path string) error {
+	data, err := ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return f.ParseFallback(data)
+}
+
+// ParseFallback is like LoadFallback but takes the contents of the font file.
+func (f *Font) ParseFallback(data []byte) error {
+	if f.size <= 0 {
+		return errors.New("the font has no size for its fallback")
+	}
+	face, err := parseFontFace(data, f.size)
+	if err != nil {
+		return err
+	}
+	f.faces = append(f.faces, face)
+
+	// Runes that were missing before might be in the new fallback.
+	for r := range f.missing {
+		delete(f.glyphs, r)
+	}
+	for pair := range f.kerning {
+		if f.missing[pair[0]] || f.missing[pair[1]] {
+			delete(f.kerning, pair)
+		}
+	}
+	f.missing = make(map[rune]bool)
+	return nil
+}
+
+// faceFor returns the first face that has a glyph for r or nil if none does.
+func (f *Font) faceFor(r rune) font.Face {
+	for _, face := range f.faces {
+		index, err := face.font.GlyphIndex(&f.buf, r)
+		if err == nil && index != 0 {
+			return face.face
+		}
+	}
+	return nil
+}
+
+// glyph returns the glyph for r, rasterizing it if necessary. Runes that are
+// not in the font or its fallbacks are drawn as a question mark.
+func (f *Font) glyph(r rune) glyph {
+	if g, ok := f.glyphs[r]; ok {
+		return g
+	}
+
+	var g glyph
+	if face := f.faceFor(r); face != nil {
+		g = f.rasterize(face, r)
+	} else if r != '?' {
+		g = f.glyph('?')
+		f.missing[r] = true
+	}
+	f.glyphs[r] = g
+	return g
+}
+
+// rasterize renders the glyph for r into the atlas, growing it if necessary.
+func (f *Font) rasterize(face font.Face, r rune) glyph {
+	dr, mask, maskp, advance, ok := face.Glyph(fixed.Point26_6{}, r)
+	if !ok {
+		return glyph{}
+	}
+
+	g := glyph{
+		width:   dr.Dx(),
+		height:  dr.Dy(),
+		offsetX: dr.Min.X,
+		offsetY: dr.Min.Y,
+		advance: float32(advance) / 64,
+	}
+	if g.width <= 0 || g.height <= 0 {
+		// White space has an advance but nothing to draw.
+		return g
+	}
+
+	g.x, g.y = f.place(g.width, g.height)
+	for y := 0; y < g.height; y++ {
+		for x := 0; x < g.width; x++ {
+			_, _, _, a := mask.At(maskp.X+x, maskp.Y+y).RGBA()
+			i := f.atlas.PixOffset(g.x+x, g.y+y)
+			f.atlas.Pix[i+0] = 255
+			f.atlas.Pix[i+1] = 255
+			f.atlas.Pix[i+2] = 255
+			f.atlas.Pix[i+3] = uint8(a >> 8)
+		}
+	}
+	f.version++
+	return g
+}
+
+// place returns the position in the atlas for a new glyph of the given size.
+// The atlas doubles its height when it is full.
+func (a *glyphAtlas) place(width, height int) (x, y int) {
+	for width+fontAtlasPadding > a.atlas.Rect.Dx() {
+		a.growAtlas(2*a.atlas.Rect.Dx(), a.atlas.Rect.Dy())
+	}
+	if a.penX+width+fontAtlasPadding > a.atlas.Rect.Dx() {
+		a.penX = 0
+		a.penY += a.rowHeight
+		a.rowHeight = 0
+	}
+	for a.penY+height+fontAtlasPadding > a.atlas.Rect.Dy() {
+		a.growAtlas(a.atlas.Rect.Dx(), 2*a.atlas.Rect.Dy())
+	}
+
+	x, y = a.penX+fontAtlasPadding, a.penY+fontAtlasPadding
+	a.penX += width + fontAtlasPadding
+	if height+fontAtlasPadding > a.rowHeight {
+		a.rowHeight = height + fontAtlasPadding
+	}
+	return x, y
+}
+
+// growAtlas makes the atlas larger, keeping its contents in the top-left.
+func (a *glyphAtlas) growAtlas(width, height int) {
+	bigger := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(bigger, a.atlas.Rect, a.atlas, image.Point{}, draw.Src)
+	a.atlas = bigger
+}
+
+// textSize returns the size of the text when drawn at the given scale.
+func (f *Font) textSize(text string, scale float32) (width, height int) {
+	if scale <= 0 {
+		return 0, 0
+	}
+	lines := strings.Split(text, "\n")
+	var maxLineW float32
+	for _, line := range lines {
+		var lineW float32
+		prev := rune(-1)
+		for _, r := range line {
+			lineW += f.kern(prev, r) + f.glyph(r).advance
+			prev = r
+		}
+		if lineW > maxLineW {
+			maxLineW = lineW
+		}
+	}
+	width = int(maxLineW*scale + 0.5)
+	height = int(float32(f.lineHeight*len(lines))*scale + 0.5)
+	return width, height
+}
+
+// kern returns the extra space between runes a and b, which is negative to
+// move them closer together. It is 0 if a is negative, i.e. at the start of a
+// line.
+func (f *Font) kern(a, b rune) float32 {
+	if a < 0 {
+		return 0
+	}
+	pair := [2]rune{a, b}
+	if k, ok := f.kerning[pair]; ok {
+		return k
+	}
+	if len(f.faces) == 0 {
+		return 0
+	}
+
+	// Only runes from the same face are kerned.
+	var k float32
+	if face := f.faceFor(a); face != nil && face == f.faceFor(b) {
+		k = float32(face.Kern(a, b)) / 64
+	}
+	if f.kerning == nil {
+		f.kerning = make(map[[2]rune]float32)
+	}
+	f.kerning[pair] = k
+	return k
+}
+
+// layout calls drawGlyph for every visible glyph of the text with its destination
+// rectangle. The text's top-left corner is at x,y.
+func (f *Font) layout(
+	text string,
+	x, y, scale float32,
+	drawGlyph func(g glyph, x, y, width, height float32),
+) {
+	penX, baseline := x, y+float32(f.ascent)*scale
+	prev := rune(-1)
+	for _, r := range text {
+		if r == '\n' {
+			penX = x
+			baseline += float32(f.lineHeight) * scale
+			prev = -1
+			continue
+		}
+
+		penX += f.kern(prev, r) * scale
+		prev = r
+		g := f.glyph(r)
+		if g.width > 0 && g.height > 0 {
+			drawGlyph(
+				g,
+				penX+float32(g.offsetX)*scale,
+				baseline+float32(g.offsetY)*scale,
+				float32(g.width)*scale,
+				float32(g.height)*scale,
+			)
+		}
+		penX += g.advance * scale
+	}
+}
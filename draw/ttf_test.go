@@ -0,0 +1,131 @@
+package draw
+
+import "testing"
+
+func TestFontMeasuresTextByGlyphAdvance(t *testing.T) {
+	f := loadTestFont(t, 20)
+
+	w, h := f.textSize("", 1)
+	if w != 0 || h != f.lineHeight {
+		t.Errorf("empty text: want 0x%d but have %dx%d", f.lineHeight, w, h)
+	}
+
+	// Go-Mono is a monospace font, all glyphs have the same advance.
+	advance := f.glyph('a').advance
+	w, h = f.textSize("abc\nde", 2)
+	if want := int(3*advance*2 + 0.5); w != want {
+		t.Errorf("want width %d but have %d", want, w)
+	}
+	if want := 2 * f.lineHeight * 2; h != want {
+		t.Errorf("want height %d but have %d", want, h)
+	}
+
+	if w, h := f.textSize("abc", -1); w != 0 || h != 0 {
+		t.Errorf("negative scale: want 0x0 but have %dx%d", w, h)
+	}
+}
+
+func TestMissingRunesAreDrawnAsQuestionMark(t *testing.T) {
+	f := loadTestFont(t, 20)
+	if f.faceFor('中') != nil {
+		t.Fatal("the test font should not have CJK glyphs")
+	}
+	if f.glyph('中') != f.glyph('?') {
+		t.Error("a missing rune should use the question mark glyph")
+	}
+}
+
+func TestFontAtlasGrowsAndKeepsGlyphs(t *testing.T) {
+	f := loadTestFont(t, 60)
+	first := f.glyph('A')
+	firstPixels := atlasPixels(f, first)
+
+	height := f.atlas.Rect.Dy()
+	for r := rune(32); r < 0x500; r++ {
+		f.glyph(r)
+	}
+	if f.atlas.Rect.Dy() <= height {
+		t.Fatalf("the atlas should have grown beyond %d pixels", height)
+	}
+	if string(atlasPixels(f, first)) != string(firstPixels) {
+		t.Error("growing the atlas changed an existing glyph")
+	}
+}
+
+func TestGlyphsDoNotOverlapInAtlas(t *testing.T) {
+	f := loadTestFont(t, 30)
+	var rects []glyph
+	for r := rune(33); r < 127; r++ {
+		g := f.glyph(r)
+		for _, other := range rects {
+			if g.x < other.x+other.width && other.x < g.x+g.width &&
+				g.y < other.y+other.height && other.y < g.y+g.height {
+				t.Fatalf("glyph %q overlaps another glyph", r)
+			}
+		}
+		rects = append(rects, g)
+	}
+}
+
+func TestLayoutPlacesGlyphsOnBaseline(t *testing.T) {
+	f := loadTestFont(t, 20)
+	var xs, ys []float32
+	f.layout("ab\na", 10, 5, 1, func(g glyph, x, y, w, h float32) {
+		xs = append(xs, x)
+		ys = append(ys, y)
+	})
+	if len(xs) != 3 {
+		t.Fatalf("want 3 glyphs but have %d", len(xs))
+	}
+	a := f.glyph('a')
+	if want := 10 + float32(a.offsetX); xs[0] != want || xs[2] != want {
+		t.Errorf("lines should start at x %v but are at %v and %v", want, xs[0], xs[2])
+	}
+	if want := 5 + float32(f.ascent+a.offsetY); ys[0] != want {
+		t.Errorf("want first glyph at y %v but have %v", want, ys[0])
+	}
+	if ys[2]-ys[0] != float32(f.lineHeight) {
+		t.Errorf("want line distance %d but have %v", f.lineHeight, ys[2]-ys[0])
+	}
+}
+
+func TestInvalidFontsAreRejected(t *testing.T) {
+	if _, err := ParseFont([]byte("not a font"), 12); err == nil {
+		t.Error("parsing garbage should fail")
+	}
+	if _, err := ParseFont(goMonoTTF, 0); err == nil {
+		t.Error("a font size of 0 should fail")
+	}
+}
+
+func loadTestFont(t *testing.T, size float32) *Font {
+	t.Helper()
+	f, err := ParseFont(goMonoTTF, size)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func atlasPixels(f *Font, g glyph) []byte {
+	var pixels []byte
+	for y := g.y; y < g.y+g.height; y++ {
+		i := f.atlas.PixOffset(g.x, y)
+		pixels = append(pixels, f.atlas.Pix[i:i+4*g.width]...)
+	}
+	return pixels
+}
+
+func TestAddingFallbackRetriesMissingRunes(t *testing.T) {
+	f := loadTestFont(t, 20)
+	f.glyph('中')
+	if !f.missing['中'] {
+		t.Fatal("中 should be missing")
+	}
+	if err := f.ParseFallback(goMonoTTF); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := f.glyphs['中']; ok || len(f.missing) != 0 {
+		t.Error("missing runes should be looked up again after adding a fallback")
+	}
+}
@@ -0,0 +1,76 @@
+package draw
+
+// ScaleMode determines how a virtual screen set with Window.SetVirtualSize is
+// scaled to the window.
+type ScaleMode int
+
+const (
+	// ScaleToFit scales the virtual screen as large as possible while keeping
+	// its aspect ratio. The scale factor can be any real number.
+	ScaleToFit ScaleMode = iota
+
+	// ScalePixelPerfect scales the virtual screen only by whole numbers, so
+	// every virtual pixel becomes a square of equally many window pixels.
+	// This makes pixel-art look crisp. If the window is smaller than the
+	// virtual screen, it is scaled down as with ScaleToFit.
+	ScalePixelPerfect
+)
+
+// virtualScreen is the logical resolution that the game draws to. It is
+// scaled up to the window and centered, with black bars around it.
+type virtualScreen struct {
+	width, height int
+	mode          ScaleMode
+}
+
+// active reports whether a virtual resolution was set. Otherwise the game
+// draws directly to the window.
+func (v virtualScreen) active() bool {
+	return v.width > 0 && v.height > 0
+}
+
+// viewport returns the rectangle in the window that the virtual screen is
+// drawn to.
+func (v virtualScreen) viewport(windowW, windowH int) (x, y, width, height int) {
+	if !v.active() || windowW <= 0 || windowH <= 0 {
+		return 0, 0, windowW, windowH
+	}
+
+	if v.mode == ScalePixelPerfect {
+		scale := minInt(windowW/v.width, windowH/v.height)
+		if scale >= 1 {
+			width, height = v.width*scale, v.height*scale
+			return (windowW - width) / 2, (windowH - height) / 2, width, height
+		}
+	}
+
+	// Fit the width first and if that makes the screen too high, fit the
+	// height instead.
+	width, height = windowW, v.height*windowW/v.width
+	if height > windowH {
+		width, height = v.width*windowH/v.height, windowH
+	}
+	return (windowW - width) / 2, (windowH - height) / 2, width, height
+}
+
+// toVirtual translates window coordinates, e.g. of the mouse, to virtual
+// screen coordinates. Positions in the black bars are outside of the virtual
+// screen.
+func (v virtualScreen) toVirtual(x, y, windowW, windowH int) (int, int) {
+	if !v.active() {
+		return x, y
+	}
+	vx, vy, vw, vh := v.viewport(windowW, windowH)
+	if vw <= 0 || vh <= 0 {
+		return x, y
+	}
+	return floorDiv((x-vx)*v.width, vw), floorDiv((y-vy)*v.height, vh)
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,57 @@
+package draw
+
+import "testing"
+
+func TestVirtualScreenFitsWindowWithBars(t *testing.T) {
+	v := virtualScreen{width: 320, height: 180, mode: ScaleToFit}
+	checkViewport(t, v, 1000, 1000, 0, 219, 1000, 562)
+	checkViewport(t, v, 1000, 180, 340, 0, 320, 180)
+	checkViewport(t, v, 160, 90, 0, 0, 160, 90)
+}
+
+func TestPixelPerfectScreenScalesByWholeNumbers(t *testing.T) {
+	v := virtualScreen{width: 320, height: 180, mode: ScalePixelPerfect}
+	checkViewport(t, v, 1000, 1000, 20, 230, 960, 540)
+	checkViewport(t, v, 1920, 1080, 0, 0, 1920, 1080)
+	checkViewport(t, v, 639, 1000, 159, 410, 320, 180)
+	// A window that is too small falls back to fitting the screen.
+	checkViewport(t, v, 160, 90, 0, 0, 160, 90)
+}
+
+func TestInactiveVirtualScreenUsesWholeWindow(t *testing.T) {
+	var v virtualScreen
+	checkViewport(t, v, 800, 600, 0, 0, 800, 600)
+	if x, y := v.toVirtual(15, -3, 800, 600); x != 15 || y != -3 {
+		t.Errorf("mouse should not be translated but is %d,%d", x, y)
+	}
+}
+
+func TestMouseIsTranslatedToVirtualScreen(t *testing.T) {
+	v := virtualScreen{width: 320, height: 180, mode: ScalePixelPerfect}
+	// The viewport is 20,230,960,540 so each virtual pixel is 3x3 pixels.
+	checkMouse(t, v, 20, 230, 0, 0)
+	checkMouse(t, v, 22, 232, 0, 0)
+	checkMouse(t, v, 23, 233, 1, 1)
+	checkMouse(t, v, 979, 769, 319, 179)
+	// Positions in the bars are outside the virtual screen.
+	checkMouse(t, v, 19, 229, -1, -1)
+	checkMouse(t, v, 980, 770, 320, 180)
+}
+
+func checkViewport(t *testing.T, v virtualScreen, windowW, windowH, x, y, w, h int) {
+	t.Helper()
+	ax, ay, aw, ah := v.viewport(windowW, windowH)
+	if ax != x || ay != y || aw != w || ah != h {
+		t.Errorf("window %dx%d: want viewport %d,%d,%d,%d but have %d,%d,%d,%d",
+			windowW, windowH, x, y, w, h, ax, ay, aw, ah)
+	}
+}
+
+func checkMouse(t *testing.T, v virtualScreen, windowX, windowY, x, y int) {
+	t.Helper()
+	ax, ay := v.toVirtual(windowX, windowY, 1000, 1000)
+	if ax != x || ay != y {
+		t.Errorf("mouse at %d,%d: want %d,%d but have %d,%d",
+			windowX, windowY, x, y, ax, ay)
+	}
+}
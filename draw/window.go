@@ -6,6 +6,7 @@
 package draw
 
 import (
+	"image"
 	"io"
 	"strconv"
 )
@@ -16,10 +17,117 @@ import (
 // signature, e.g. to open files from an embed.FS.
 var OpenFile func(path string) (io.ReadCloser, error) = DefaultOpenFile
 
+// HighDPI makes the window render at the native resolution of high-DPI
+// displays. Set it before calling RunWindow. By default, the operating system
+// or browser scales the window up on high-DPI displays, which looks blurry.
+// If HighDPI is true, the window size passed to RunWindow is scaled by the
+// display's content scale. Window.Size, all drawing coordinates and the mouse
+// position are then in physical pixels. Use Window.ContentScale to scale your
+// UI accordingly.
+var HighDPI = false
+
+// ReadFile reads the whole file at the given path through OpenFile. Use it to
+// load your own data files, e.g. levels or maps, the same way that images and
+// sounds are loaded. If OpenFile is nil on WASM, the file is fetched from the
+// URL given by path. Fetching blocks until the download is complete, which is
+// why on WASM you must call ReadFile before RunWindow or from a goroutine, not
+// from inside the update function.
+func ReadFile(path string) ([]byte, error) {
+	if OpenFile == nil {
+		return fetchFile(path)
+	}
+
+	f, err := OpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return io.ReadAll(f)
+}
+
 // UpdateFunction is used as a callback when creating a window. It is called
-// at 60Hz and you do all your event handling and drawing in it.
+// at 60Hz by default (see Window.SetFrameRate) and you do all your event
+// handling and drawing in it.
 type UpdateFunction func(window Window)
 
+// RunWindow creates a new window and calls update 60 times per second. It
+// returns when the window is closed. Use RunWindowWithOptions for more
+// settings.
+func RunWindow(title string, width, height int, update UpdateFunction) error {
+	return RunWindowWithOptions(WindowOptions{
+		Title:  title,
+		Width:  width,
+		Height: height,
+	}, update)
+}
+
+// WindowOptions are the settings for RunWindowWithOptions. The zero value of
+// each field gives the same behavior as RunWindow.
+type WindowOptions struct {
+	// Title is the window title, or the page title in the browser.
+	Title string
+
+	// Width and Height are the size of the window's drawing area in pixels.
+	Width, Height int
+
+	// X and Y are the initial screen position of the window's drawing area.
+	// They are only used if Positioned is true, otherwise the window is
+	// centered on the screen. They are ignored in the browser.
+	X, Y       int
+	Positioned bool
+
+	// Fullscreen starts the window in fullscreen mode. In the browser this
+	// only happens after the first key press or mouse click.
+	Fullscreen bool
+
+	// Borderless creates a window without border and title bar. It is
+	// ignored in the browser.
+	Borderless bool
+
+	// Resizable lets the user resize the window, see Window.SetResizable.
+	Resizable bool
+
+	// AlwaysOnTop keeps the window above all other windows. It is ignored in
+	// the browser.
+	AlwaysOnTop bool
+
+	// DisableVSync stops waiting for the monitor's vertical blank before
+	// showing a frame. This reduces latency but might show tearing. The
+	// browser always synchronizes to the monitor.
+	DisableVSync bool
+
+	// FrameRate is the number of times per second that update is called. It
+	// defaults to 60, see Window.SetFrameRate.
+	FrameRate int
+
+	// ClearColor is the color that the window is cleared to before each
+	// update. It defaults to black.
+	ClearColor Color
+
+	// ShowConsole keeps the console window visible on Windows if it was
+	// created for this program, e.g. when double-clicking the executable of
+	// a program not built with -H=windowsgui. By default it is hidden.
+	ShowConsole bool
+
+	// CanvasID is the id of the HTML canvas element that is drawn to in the
+	// browser. It defaults to "gameCanvas".
+	CanvasID string
+}
+
+// withDefaults returns the options with all unset fields set to their
+// default values.
+func (opts WindowOptions) withDefaults() WindowOptions {
+	opts.FrameRate = normalizeFrameRate(opts.FrameRate)
+	if opts.ClearColor == (Color{}) {
+		opts.ClearColor = Black
+	}
+	if opts.CanvasID == "" {
+		opts.CanvasID = "gameCanvas"
+	}
+	return opts
+}
+
 // ErrImageLoading is returned by the Window.ImageSize and Window.DrawImage...
 // functions when the requested image is still being loaded. This only happens
 // on WASM, as the JavaScript runtime will load images asynchronously.
@@ -47,13 +155,55 @@ type Window interface {
 	// fails, it returns an error.
 	SetIcon(path string) error
 
-	// Size returns the window's size in pixels.
+	// Size returns the window's size in pixels. If a virtual size is set, this
+	// returns the virtual size instead.
 	Size() (width, height int)
 
+	// ContentScale returns the scale factor of the display that the window is
+	// on, e.g. 2 on a retina display or 1.5 if Windows' display scaling is
+	// set to 150%. See HighDPI for rendering at the native resolution.
+	ContentScale() float32
+
+	// SetFrameRate sets the number of times per second that update is called.
+	// The default is 60. Pass UnlimitedFrameRate to update as often as
+	// possible.
+	SetFrameRate(framesPerSecond int)
+
+	// DeltaTime returns the time in seconds that passed between the last
+	// update and this one. Use it to move things at the same speed
+	// regardless of the frame rate.
+	// At a fixed frame rate, this is always 1 / frame rate, also for updates
+	// that catch up on missed frames. At UnlimitedFrameRate, it is the
+	// measured time between updates.
+	DeltaTime() float64
+
+	// FrameCount returns the number of the current update, the first one is
+	// 0.
+	FrameCount() int
+
+	// FPS returns the measured number of updates per second, averaged over
+	// the last second.
+	FPS() float64
+
+	// FrameStats returns timing statistics of the frames shown in the last
+	// second. Use them to check that the game runs at an even pace.
+	FrameStats() FrameStats
+
+	// SetVirtualSize sets a logical resolution that you draw to, independent
+	// of the actual window size. Everything you draw is scaled to the window
+	// or fullscreen size, keeping the aspect ratio and filling the rest of the
+	// window with black bars. Use ScalePixelPerfect for pixel-art games to
+	// only scale by whole numbers.
+	// Size, MousePosition and Clicks use the virtual coordinates after this.
+	// Setting a width or height <= 0 turns the virtual size off again, which
+	// is the default.
+	SetVirtualSize(width, height int, mode ScaleMode)
+
 	// SetFullscreen toggles between the fixed-size window with title and border
 	// and going full screen on the monitor that the window is placed on when
 	// the call to SetFullscreen(true) occurs.
-	// Use Window.Size to get the new size after this.
+	// Use Window.Size to get the new size after this, or use SetVirtualSize to
+	// keep drawing at the same size.
 	// By default the window is not fullscreen. It always starts windowed.
 	SetFullscreen(f bool)
 
@@ -63,6 +213,75 @@ type Window interface {
 	// through SetFullscreen.
 	IsFullscreen() bool
 
+	// Monitors returns all monitors connected to the computer. Use an index
+	// into this list with SetFullscreenMode. In the browser it returns a
+	// single monitor for the screen that the page is shown on, its sizes are
+	// in CSS pixels.
+	Monitors() []Monitor
+
+	// SetFullscreenMode goes fullscreen on the monitor with the given index in
+	// Monitors, or the primary monitor if the index is invalid.
+	// If mode is the zero VideoMode, the window covers the monitor at its
+	// current resolution, this is called borderless fullscreen. Otherwise the
+	// monitor is switched to the given mode, which should be one of its
+	// Modes, this is called exclusive fullscreen. The monitor's mode is
+	// restored when leaving fullscreen with SetFullscreen(false).
+	// In the browser, monitor and mode are ignored and this is the same as
+	// SetFullscreen(true).
+	SetFullscreenMode(monitor int, mode VideoMode)
+
+	// SetResizable lets the user resize the window by dragging its border or
+	// maximizing it. By default the window has a fixed size. In the browser,
+	// a resizable canvas fills the whole browser window.
+	// Use Size to get the new size and WasResized to know when it changed.
+	SetResizable(resizable bool)
+
+	// WasResized reports whether the window size changed during the last
+	// frame, e.g. because the user resized the window or it went fullscreen.
+	WasResized() bool
+
+	// SetSizeLimits sets the minimum and maximum size that the user can
+	// resize the window to. The sizes are for the drawing area of the window,
+	// in pixels. Pass 0 for any of them to not limit it. The limits do not
+	// apply in fullscreen mode.
+	SetSizeLimits(minWidth, minHeight, maxWidth, maxHeight int)
+
+	// SetTitle changes the window title, or the page title in the browser.
+	SetTitle(title string)
+
+	// SetPosition moves the window so that the top-left corner of its drawing
+	// area is at the given screen position. It is ignored in fullscreen mode
+	// and in the browser.
+	SetPosition(x, y int)
+
+	// Position returns the screen position of the top-left corner of the
+	// window's drawing area. In the browser it always returns 0, 0.
+	Position() (x, y int)
+
+	// Minimize minimizes the window to the task bar. It is ignored in the
+	// browser.
+	Minimize()
+
+	// Restore restores the window after it was minimized or maximized. It is
+	// ignored in the browser.
+	Restore()
+
+	// RequestAttention highlights the window in the task bar to tell the user
+	// that something happened, without taking the focus. It is ignored in the
+	// browser.
+	RequestAttention()
+
+	// HasFocus reports whether the window currently receives keyboard input.
+	// A game would typically pause while it does not have the focus, e.g.
+	// after the user switched to another window or browser tab.
+	HasFocus() bool
+
+	// GainedFocus and LostFocus report whether the window gained or lost the
+	// focus during the last frame. All keys and mouse buttons are released
+	// when the window loses the focus.
+	GainedFocus() bool
+	LostFocus() bool
+
 	// ShowCursor set the OS' mouse cursor to visible or invisible. It defaults
 	// to visible if you do not call ShowCursor.
 	ShowCursor(show bool)
@@ -84,6 +303,17 @@ type Window interface {
 	// time that the keys were entered.
 	Characters() string
 
+	// ClipboardText returns the text in the system clipboard, or "" if it
+	// contains no text. Lines are separated by '\n'.
+	// In the browser, reading the clipboard is asynchronous and may need the
+	// user's permission. ClipboardText returns the text that was read last.
+	// The clipboard is read when the user presses Ctrl+V and the key press is
+	// reported once the text is there, so pasting on Ctrl+V works as usual.
+	ClipboardText() string
+
+	// SetClipboardText puts the text into the system clipboard.
+	SetClipboardText(text string)
+
 	// IsMouseDown reports whether the specified button is down at the time of
 	// the function call
 	IsMouseDown(button MouseButton) bool
@@ -146,6 +376,12 @@ type Window interface {
 	// returned.
 	DrawImageFileTo(path string, x, y, w, h, rotationCWDeg int) error
 
+	// DrawImageFileTinted is like DrawImageFileTo but multiplies every pixel
+	// of the image with the tint color. Use White to draw the image as is,
+	// Black with a lower alpha to draw a translucent shadow of it or a color
+	// with alpha < 1 to fade the image out.
+	DrawImageFileTinted(path string, x, y, w, h, rotationCWDeg int, tint Color) error
+
 	// DrawImageFileRotated draws the image with its top-left corner at the
 	// given coordinates but roatated clockwise about the given angle in degrees
 	// around its center. This means its top-left corner will only actually be
@@ -177,17 +413,95 @@ type Window interface {
 		rotationCWDeg int,
 	) error
 
+	// DrawPixels draws the image with its top-left corner at x,y, one image
+	// pixel per screen pixel. Use it for graphics that you compute yourself
+	// pixel by pixel, e.g. fire effects, plasmas, emulators or cellular
+	// automata. This is much faster than calling DrawPoint for every pixel.
+	// The image is copied to the screen every time you call DrawPixels so you
+	// can change it freely between calls. Only the image's bounds are drawn,
+	// use SubImage to draw a region of a larger image. Like all image.RGBA
+	// colors, the pixels are alpha-premultiplied.
+	DrawPixels(x, y int, img *image.RGBA)
+
+	// DrawPixelsTo is like DrawPixels but scales the image to the given screen
+	// rectangle, see BlurImages.
+	DrawPixelsTo(x, y, width, height int, img *image.RGBA)
+
+	// PixelAt returns the color at x,y of what was drawn so far in this frame.
+	// All drawing that you did before calling PixelAt is finished first, so it
+	// is included in the result. The position is in drawing coordinates, i.e.
+	// in the virtual screen if a virtual size is set. The returned alpha is
+	// always 1, except for positions outside the screen, where the zero Color
+	// is returned.
+	// Reading back from the graphics card is slow, so only call this a few
+	// times per frame, e.g. for picking the color under the mouse.
+	PixelAt(x, y int) Color
+
 	// BlurImages sets the state for future calls to any of the
-	// DrawImageFile... functions. Setting blur to true will draw images using
-	// anti-aliasing. Setting blur to false will use nearest-neighbor sampling
-	// when scaling images.
+	// DrawImageFile... and DrawPixels... functions and DrawNinePatch. Setting
+	// blur to true will draw images using anti-aliasing. Setting blur to false
+	// will use nearest-neighbor sampling when scaling images.
 	BlurImages(blur bool)
 
+	// DrawNinePatch draws the image stretched to the given screen rectangle
+	// while keeping its corners unscaled. The insets give the size of the
+	// corners in the image, in pixels. The edges between the corners are only
+	// stretched along their length, the center is stretched in both
+	// directions. This is how you draw UI panels and buttons of any size from
+	// a single small image. If the rectangle is too small for the corners,
+	// they are shrunk.
+	//
+	// If the path ends in ".9.png", the file is treated as an Android-style
+	// nine-patch image. Black pixels in its top and left 1 pixel border mark
+	// the stretchable area. In this case the insets are read from the image and
+	// the inset arguments are ignored. The border itself is not drawn and is
+	// not part of the image size.
+	//
+	// If the image file is not found or has the wrong format an error is
+	// returned.
+	DrawNinePatch(
+		path string,
+		insetLeft, insetTop, insetRight, insetBottom int,
+		x, y, width, height int,
+	) error
+
+	// TileNinePatches sets the state for future calls to DrawNinePatch.
+	// Setting tile to true repeats the edges and center of the image instead of
+	// stretching them. It defaults to false.
+	TileNinePatches(tile bool)
+
+	// SetFont selects the font for DrawText, DrawScaledText, GetTextSize and
+	// GetScaledTextSize. Load fonts with LoadFont, ParseFont, LoadBitmapFont
+	// or LoadBMFont. Passing nil selects the built-in font, which is the
+	// default.
+	SetFont(font *Font)
+
+	// AddFallbackFont loads a TrueType (.ttf) or OpenType (.otf) font file for
+	// characters that the built-in font does not have. The built-in font has
+	// Latin, Greek and Cyrillic letters. Add fallback fonts e.g. for Chinese,
+	// Japanese or Korean text. They are searched in the order that they were
+	// added. For fonts from LoadFont, use Font.LoadFallback instead.
+	AddFallbackFont(path string) error
+
+	// SetTextOutline draws all following text with an outline of the given
+	// width in pixels around each character. The width is for text at scale 1
+	// and grows and shrinks with the text's scale. A width of 0 or a fully
+	// transparent color turns the outline off, which is the default. This
+	// applies to all text drawing functions.
+	SetTextOutline(width int, color Color)
+
+	// SetTextShadow draws all following text with a drop shadow behind it,
+	// offset by dx,dy pixels at scale 1. If the text has an outline, the
+	// shadow has the shape of the outline. An offset of 0,0 or a fully
+	// transparent color turns the shadow off, which is the default. This
+	// applies to all text drawing functions.
+	SetTextShadow(dx, dy int, color Color)
+
 	// GetTextSize returns the size the given text would have when being drawn.
 	GetTextSize(text string) (w, h int)
 
 	// GetScaledTextSize returns the size the given text would have when being
-	// drawn at the given scale.
+	// drawn at the given scale. It is 0,0 for scales <= 0, which draw nothing.
 	GetScaledTextSize(text string, scale float32) (w, h int)
 
 	// DrawText draws a text string. New line characters ('\n') are not drawn
@@ -200,6 +514,65 @@ type Window interface {
 	// scales < 1 shrink it. Scales <= 0 will draw no text at all.
 	DrawScaledText(text string, x, y int, scale float32, color Color)
 
+	// DrawTransformedText draws the text scaled by scaleX and scaleY and
+	// rotated clockwise by rotationCWDeg degrees around an anchor point. The
+	// anchor is relative to the text's size: 0,0 is its top-left corner,
+	// 0.5,0.5 its center and 1,1 its bottom-right corner. The anchor is placed
+	// at x,y. Negative scales mirror the text. With an anchor of 0,0, scales of
+	// 1 and no rotation this is the same as DrawText.
+	DrawTransformedText(
+		text string,
+		x, y int,
+		anchorX, anchorY float32,
+		scaleX, scaleY float32,
+		rotationCWDeg int,
+		color Color,
+	)
+
+	// DrawRichText draws text with markup for parts of it, e.g.
+	//
+	//     "Press [color=yellow]E[/color] to [b]open[/b] the door."
+	//
+	// The tags are:
+	//
+	//     [color=yellow]...[/color]     a color name like Yellow or DarkGray
+	//     [color=#FF8000]...[/color]    or #RRGGBB or #RRGGBBAA in hex
+	//     [scale=1.5]...[/scale]        a scale relative to the text's
+	//     [b]...[/b]                    bold
+	//     [wave]...[/wave]              characters move up and down
+	//     [shake]...[/shake]            characters jitter in place
+	//
+	// Tags can be nested. Write [[ for a literal [. Tags that are not
+	// understood are drawn as text. Parts with different scales on the same
+	// line are aligned at the bottom. Text without markup is drawn exactly
+	// like with DrawText.
+	DrawRichText(text string, x, y int, color Color)
+
+	// DrawScaledRichText is like DrawRichText but the whole text is scaled,
+	// see DrawScaledText.
+	DrawScaledRichText(text string, x, y int, scale float32, color Color)
+
+	// GetRichTextSize returns the size of the text when drawn with
+	// DrawRichText. Text without markup has the same size as with GetTextSize.
+	GetRichTextSize(text string) (w, h int)
+
+	// GetScaledRichTextSize returns the size of the text when drawn with
+	// DrawScaledRichText.
+	GetScaledRichTextSize(text string, scale float32) (w, h int)
+
+	// DrawTextBox draws the text inside the box at x,y with the given width
+	// and height. Lines are wrapped at spaces to fit the width, words that are
+	// too long for a line of their own are broken. New line characters ('\n')
+	// force a line break. The align flags position the lines in the box, e.g.
+	// AlignCenter|AlignMiddle. Only lines that fit completely into the box are
+	// drawn. If the text is cut off, the last line ends in "...".
+	DrawTextBox(text string, x, y, width, height int, align TextAlign, color Color)
+
+	// GetTextBoxSize returns the size of the text when it is wrapped to the
+	// given width, as in DrawTextBox. The height includes all lines, also those
+	// that DrawTextBox would cut off for a box that is too small.
+	GetTextBoxSize(text string, width int) (w, h int)
+
 	// PlaySoundFile only plays WAV sounds. If the file is not found or has the
 	// wrong format an error is returned.
 	PlaySoundFile(path string) error
@@ -14,12 +14,10 @@ import (
 	"io"
 	"math"
 	"runtime"
-	"strings"
 	"time"
-	"unicode/utf8"
 
 	"github.com/gonutz/gl/v2.1/gl"
-	"github.com/gonutz/glfw/v3.3/glfw"
+	"github.com/gonutz/prototype/internal/glfw"
 )
 
 func init() {
@@ -34,6 +32,12 @@ type window struct {
 	typed          []rune
 	window         *glfw.Window
 	width, height  float64
+	screenWidth    int
+	screenHeight   int
+	fbWidth        int
+	fbHeight       int
+	originalX      int
+	originalY      int
 	originalWidth  int
 	originalHeight int
 	fullscreen     bool
@@ -42,12 +46,39 @@ type window struct {
 	mouseX, mouseY int
 	wheelX, wheelY float64
 	blurImages     bool
+	tileNinePatch  bool
 	iconPath       string
 	showingCursor  bool
+	virtual        virtualScreen
+	virtualTarget  renderTarget
+	pixels         pixelTexture
+	font           *Font
+	fontTextures   map[*glyphAtlas]fontTexture
+	fallbackFont   *Font
+	textEffects    textEffects
+	resizable      bool
+	resized        bool
+	clearColor     Color
+	frameRate      int
+	timer          frameTimer
+	stats          frameStatsCollector
+	focused        bool
+	gainedFocus    bool
+	lostFocus      bool
 }
 
-// RunWindow creates a new window and calls update 60 times per second.
-func RunWindow(title string, width, height int, update UpdateFunction) error {
+// renderTarget is an offscreen texture that we draw to when a virtual size is
+// set. At the end of the frame it is scaled to the window.
+type renderTarget struct {
+	fbo, texture  uint32
+	width, height int
+	bound         bool
+}
+
+func RunWindowWithOptions(opts WindowOptions, update UpdateFunction) error {
+	opts = opts.withDefaults()
+	width, height := opts.Width, opts.Height
+
 	if err := initSound(); err != nil {
 		return err
 	}
@@ -61,24 +92,41 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 
 	glfw.WindowHint(glfw.ContextVersionMajor, 1)
 	glfw.WindowHint(glfw.ContextVersionMinor, 0)
-	glfw.WindowHint(glfw.Resizable, glfw.False)
+	glfw.WindowHint(glfw.Resizable, glfwBool(opts.Resizable))
+	glfw.WindowHint(glfw.Decorated, glfwBool(!opts.Borderless))
+	glfw.WindowHint(glfw.Floating, glfwBool(opts.AlwaysOnTop))
+	if HighDPI {
+		glfw.WindowHint(glfw.ScaleToMonitor, glfw.True)
+		glfw.WindowHint(glfw.CocoaRetinaFramebuffer, glfw.True)
+	} else {
+		glfw.WindowHint(glfw.CocoaRetinaFramebuffer, glfw.False)
+	}
 
-	win, err := glfw.CreateWindow(width, height, title, nil, nil)
+	win, err := glfw.CreateWindow(width, height, opts.Title, nil, nil)
 	if err != nil {
 		return err
 	}
 	win.MakeContextCurrent()
-	// center the window on the screen (omitting the window border)
-	screen := glfw.GetMonitors()[0].GetVideoMode()
-	win.SetPos((screen.Width-width)/2, (screen.Height-height)/2)
+	if opts.DisableVSync {
+		glfw.SwapInterval(0)
+	} else {
+		glfw.SwapInterval(1)
+	}
+	// center the window on the screen (omitting the window border) unless a
+	// position was given, its size might have been scaled for high-DPI
+	// monitors
+	width, height = win.GetSize()
+	if opts.Positioned {
+		win.SetPos(opts.X, opts.Y)
+	} else {
+		screen := glfw.GetMonitors()[0].GetVideoMode()
+		win.SetPos((screen.Width-width)/2, (screen.Height-height)/2)
+	}
 
 	err = gl.Init()
 	if err != nil {
 		return err
 	}
-	gl.MatrixMode(gl.PROJECTION)
-	gl.Ortho(0, float64(width), float64(height), 0, -1, 1)
-	gl.MatrixMode(gl.MODELVIEW)
 	gl.Enable(gl.BLEND)
 	gl.BlendFunc(gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA)
 
@@ -87,11 +135,15 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 		window:         win,
 		originalWidth:  width,
 		originalHeight: height,
-		width:          float64(width),
-		height:         float64(height),
 		textures:       make(map[string]texture),
 		showingCursor:  true,
+		resizable:      opts.Resizable,
+		clearColor:     opts.ClearColor,
+		frameRate:      opts.FrameRate,
+		timer:          newFrameTimer(),
 	}
+	w.updateSize()
+	w.resized = false
 	defer w.ShowCursor(true)
 	win.SetKeyCallback(w.keyPress)
 	win.SetCharCallback(w.charTyped)
@@ -101,39 +153,69 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 		w.wheelX += dx
 		w.wheelY += dy
 	})
-	win.SetSizeCallback(func(_ *glfw.Window, width, height int) {
-		w.width, w.height = float64(width), float64(height)
-		gl.MatrixMode(gl.PROJECTION)
-		gl.LoadIdentity()
-		gl.Ortho(0, w.width, w.height, 0, -1, 1)
-		gl.Viewport(0, 0, int32(width), int32(height))
-		gl.MatrixMode(gl.MODELVIEW)
+	win.SetSizeCallback(func(*glfw.Window, int, int) {
+		w.updateSize()
+	})
+	win.SetFramebufferSizeCallback(func(*glfw.Window, int, int) {
+		w.updateSize()
+	})
+	// GLFW releases all keys and mouse buttons when the focus is lost.
+	w.focused = win.GetAttrib(glfw.Focused) == glfw.True
+	win.SetFocusCallback(func(_ *glfw.Window, focused bool) {
+		w.focused = focused
+		if focused {
+			w.gainedFocus = true
+		} else {
+			w.lostFocus = true
+		}
 	})
 
 	w.loadTexture(bytes.NewReader(bitmapFontWhitePng[:]), fontTextureID)
 
-	lastUpdateTime := time.Now().Add(-time.Hour)
-	const updateInterval = 1.0 / 60.0
+	if opts.Fullscreen {
+		w.SetFullscreen(true)
+	}
+
+	// With VSync, SwapBuffers waits for the monitor so we only need to know
+	// which refresh an update belongs to and can let it run a little early.
+	// Without VSync we have to be precise. Either way, we sleep until the next
+	// update is due instead of polling.
+	var pacer framePacer
+	tolerance := fixedStepTolerance
+	if opts.DisableVSync {
+		tolerance = 0
+	}
 	for w.running && !win.ShouldClose() {
 		glfw.PollEvents()
 
+		updates := pacer.updates(time.Now(), frameInterval(w.frameRate), tolerance)
+		if updates == 0 {
+			// The next update may run tolerance before its deadline.
+			sleepUntil(pacer.next.Add(-tolerance))
+			continue
+		}
+
+		// When catching up on missed frames, only the last update is shown.
 		now := time.Now()
-		if now.Sub(lastUpdateTime).Seconds() > updateInterval {
-			gl.ClearColor(0, 0, 0, 1)
-			gl.Clear(gl.COLOR_BUFFER_BIT)
+		for i := 0; i < updates && w.running; i++ {
+			w.timer.startFrame(now, w.frameRate)
+			w.clear()
+			w.beginVirtualScreen()
 			update(w)
+			w.endVirtualScreen()
 
 			w.pressed = w.pressed[:0]
 			w.typed = w.typed[:0]
 			w.clicks = w.clicks[:0]
 			w.wheelX = 0
 			w.wheelY = 0
-
-			lastUpdateTime = now
-			win.SwapBuffers()
-		} else {
-			time.Sleep(time.Millisecond)
+			w.resized = false
+			w.gainedFocus = false
+			w.lostFocus = false
 		}
+
+		win.SwapBuffers()
+		w.stats.frameShown(time.Now(), updates)
 	}
 
 	w.cleanUp()
@@ -143,6 +225,19 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 
 const fontTextureID = "///font"
 
+func glfwBool(b bool) int {
+	if b {
+		return glfw.True
+	}
+	return glfw.False
+}
+
+func (w *window) clear() {
+	c := w.clearColor
+	gl.ClearColor(c.R, c.G, c.B, c.A)
+	gl.Clear(gl.COLOR_BUFFER_BIT)
+}
+
 func (w *window) Close() {
 	w.running = false
 }
@@ -171,30 +266,358 @@ func (w *window) SetIcon(path string) error {
 }
 
 func (w *window) Size() (int, int) {
+	if w.virtual.active() {
+		return w.virtual.width, w.virtual.height
+	}
+	return w.windowSize()
+}
+
+func (w *window) windowSize() (int, int) {
 	return int(w.width + 0.5), int(w.height + 0.5)
 }
 
+// updateSize reads the window and framebuffer sizes. They differ on high-DPI
+// displays on macOS. We draw in window coordinates unless HighDPI is set, in
+// which case we draw in framebuffer pixels.
+func (w *window) updateSize() {
+	w.screenWidth, w.screenHeight = w.window.GetSize()
+	w.fbWidth, w.fbHeight = w.window.GetFramebufferSize()
+
+	width, height := w.screenWidth, w.screenHeight
+	if HighDPI {
+		width, height = w.fbWidth, w.fbHeight
+	}
+	if float64(width) != w.width || float64(height) != w.height {
+		w.resized = true
+	}
+	w.width, w.height = float64(width), float64(height)
+	w.setProjection()
+}
+
+// setProjection maps the drawing coordinates to the whole framebuffer.
+func (w *window) setProjection() {
+	width, height := w.windowSize()
+	setProjection(width, height, w.fbWidth, w.fbHeight)
+}
+
+// setProjection maps a width x height area to the viewport, which is given in
+// pixels.
+func setProjection(width, height, viewportWidth, viewportHeight int) {
+	gl.MatrixMode(gl.PROJECTION)
+	gl.LoadIdentity()
+	gl.Ortho(0, float64(width), float64(height), 0, -1, 1)
+	gl.Viewport(0, 0, int32(viewportWidth), int32(viewportHeight))
+	gl.MatrixMode(gl.MODELVIEW)
+}
+
+// toDrawing converts a position in screen coordinates, as GLFW reports the
+// cursor position, to drawing coordinates.
+func (w *window) toDrawing(x, y float64) (int, int) {
+	if w.screenWidth > 0 && w.screenHeight > 0 {
+		x *= w.width / float64(w.screenWidth)
+		y *= w.height / float64(w.screenHeight)
+	}
+	return int(math.Floor(x)), int(math.Floor(y))
+}
+
+func (w *window) SetFrameRate(framesPerSecond int) {
+	w.frameRate = normalizeFrameRate(framesPerSecond)
+}
+
+func (w *window) DeltaTime() float64 {
+	return w.timer.deltaTime()
+}
+
+func (w *window) FrameCount() int {
+	return w.timer.frameCount()
+}
+
+func (w *window) FPS() float64 {
+	return w.timer.fps
+}
+
+func (w *window) FrameStats() FrameStats {
+	return w.stats.stats
+}
+
+func (w *window) ContentScale() float32 {
+	scale, _ := w.window.GetContentScale()
+	return scale
+}
+
+func (w *window) SetVirtualSize(width, height int, mode ScaleMode) {
+	v := virtualScreen{width: width, height: height, mode: mode}
+	if !v.active() {
+		v = virtualScreen{}
+	}
+	if v == w.virtual {
+		return
+	}
+
+	// The new size takes effect right away. What was drawn so far in this
+	// frame is scaled to the window with the old settings.
+	w.endVirtualScreen()
+	w.virtual = v
+	w.beginVirtualScreen()
+}
+
+// beginVirtualScreen redirects all drawing to the offscreen render target if
+// a virtual size is set.
+func (w *window) beginVirtualScreen() {
+	if !w.virtual.active() {
+		return
+	}
+
+	t := &w.virtualTarget
+	if t.fbo == 0 || t.width != w.virtual.width || t.height != w.virtual.height {
+		w.deleteRenderTarget()
+		t.width, t.height = w.virtual.width, w.virtual.height
+
+		gl.GenTextures(1, &t.texture)
+		gl.BindTexture(gl.TEXTURE_2D, t.texture)
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+		gl.TexImage2D(
+			gl.TEXTURE_2D,
+			0,
+			gl.RGBA,
+			int32(t.width),
+			int32(t.height),
+			0,
+			gl.RGBA,
+			gl.UNSIGNED_BYTE,
+			nil,
+		)
+		gl.BindTexture(gl.TEXTURE_2D, 0)
+
+		gl.GenFramebuffersEXT(1, &t.fbo)
+		gl.BindFramebufferEXT(gl.FRAMEBUFFER_EXT, t.fbo)
+		gl.FramebufferTexture2DEXT(
+			gl.FRAMEBUFFER_EXT,
+			gl.COLOR_ATTACHMENT0_EXT,
+			gl.TEXTURE_2D,
+			t.texture,
+			0,
+		)
+	}
+
+	gl.BindFramebufferEXT(gl.FRAMEBUFFER_EXT, t.fbo)
+	setProjection(t.width, t.height, t.width, t.height)
+	w.clear()
+	t.bound = true
+}
+
+// endVirtualScreen draws the offscreen render target, scaled to the window.
+func (w *window) endVirtualScreen() {
+	t := &w.virtualTarget
+	if !t.bound {
+		return
+	}
+	t.bound = false
+
+	gl.BindFramebufferEXT(gl.FRAMEBUFFER_EXT, 0)
+	w.setProjection()
+	// The bars around the scaled screen are black, not the clear color.
+	gl.ClearColor(0, 0, 0, 1)
+	gl.Clear(gl.COLOR_BUFFER_BIT)
+	windowW, windowH := w.windowSize()
+	x, y, width, height := w.virtual.viewport(windowW, windowH)
+
+	filter := int32(gl.LINEAR)
+	if w.virtual.mode == ScalePixelPerfect {
+		filter = gl.NEAREST
+	}
+
+	gl.Enable(gl.TEXTURE_2D)
+	gl.BindTexture(gl.TEXTURE_2D, t.texture)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, filter)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, filter)
+
+	// The render target is opaque, blending it would darken the colors where
+	// its alpha is less than 1.
+	gl.Disable(gl.BLEND)
+	defer gl.Enable(gl.BLEND)
+
+	// The texture's rows are stored bottom-up, so we flip the v coordinate.
+	gl.Begin(gl.QUADS)
+	gl.Color4f(1, 1, 1, 1)
+	gl.TexCoord2i(0, 1)
+	gl.Vertex2i(int32(x), int32(y))
+	gl.TexCoord2i(1, 1)
+	gl.Vertex2i(int32(x+width), int32(y))
+	gl.TexCoord2i(1, 0)
+	gl.Vertex2i(int32(x+width), int32(y+height))
+	gl.TexCoord2i(0, 0)
+	gl.Vertex2i(int32(x), int32(y+height))
+	gl.End()
+
+	gl.Disable(gl.TEXTURE_2D)
+}
+
+func (w *window) deleteRenderTarget() {
+	t := &w.virtualTarget
+	if t.fbo != 0 {
+		gl.DeleteFramebuffersEXT(1, &t.fbo)
+		gl.DeleteTextures(1, &t.texture)
+	}
+	*t = renderTarget{}
+}
+
 func (w *window) SetFullscreen(f bool) {
 	if f == w.fullscreen {
 		return
 	}
-	w.fullscreen = f
 
-	if w.fullscreen {
-		monitor := monitorContaining(w.window.GetPos())
-		mode := monitor.GetVideoMode()
-		w.window.SetMonitor(monitor, 0, 0, mode.Width, mode.Height, 60)
+	if f {
+		w.enterFullscreen(monitorContaining(w.window.GetPos()), VideoMode{})
 	} else {
-		screen := w.window.GetMonitor().GetVideoMode()
-		newW, newH := w.originalWidth, w.originalHeight
-		w.window.SetMonitor(nil, (screen.Width-newW)/2, (screen.Height-newH)/2, newW, newH, 60)
+		w.fullscreen = false
+		// Leaving the monitor also restores its video mode.
+		w.window.SetMonitor(
+			nil,
+			w.originalX, w.originalY,
+			w.originalWidth, w.originalHeight,
+			glfw.DontCare,
+		)
+	}
+}
+
+func (w *window) SetFullscreenMode(monitor int, mode VideoMode) {
+	monitors := glfw.GetMonitors()
+	if len(monitors) == 0 {
+		return
+	}
+	if monitor < 0 || monitor >= len(monitors) {
+		// GLFW always lists the primary monitor first.
+		monitor = 0
+	}
+	w.enterFullscreen(monitors[monitor], mode)
+}
+
+// enterFullscreen puts the window on the monitor. A zero mode keeps the
+// monitor's current video mode.
+func (w *window) enterFullscreen(monitor *glfw.Monitor, mode VideoMode) {
+	if !w.fullscreen {
+		// Remember the window's size and position to restore them, the user
+		// might have moved or resized the window.
+		w.originalX, w.originalY = w.window.GetPos()
+		w.originalWidth, w.originalHeight = w.window.GetSize()
+	}
+	w.fullscreen = true
+
+	if mode == (VideoMode{}) {
+		// With the current video mode GLFW does not switch modes and instead
+		// makes the window cover the monitor.
+		current := monitor.GetVideoMode()
+		mode = VideoMode{current.Width, current.Height, current.RefreshRate}
 	}
+	refresh := mode.RefreshRate
+	if refresh <= 0 {
+		refresh = glfw.DontCare
+	}
+	w.window.SetMonitor(monitor, 0, 0, mode.Width, mode.Height, refresh)
 }
 
 func (w *window) IsFullscreen() bool {
 	return w.fullscreen
 }
 
+func (w *window) SetResizable(resizable bool) {
+	if resizable == w.resizable {
+		return
+	}
+	w.resizable = resizable
+
+	if resizable {
+		w.window.SetAttrib(glfw.Resizable, glfw.True)
+	} else {
+		w.window.SetAttrib(glfw.Resizable, glfw.False)
+	}
+}
+
+func (w *window) WasResized() bool {
+	return w.resized
+}
+
+func (w *window) SetSizeLimits(minWidth, minHeight, maxWidth, maxHeight int) {
+	limit := func(size int) int {
+		if size <= 0 {
+			return glfw.DontCare
+		}
+		return size
+	}
+	w.window.SetSizeLimits(
+		limit(minWidth), limit(minHeight),
+		limit(maxWidth), limit(maxHeight),
+	)
+}
+
+func (w *window) SetTitle(title string) {
+	w.window.SetTitle(title)
+}
+
+func (w *window) SetPosition(x, y int) {
+	if !w.fullscreen {
+		w.window.SetPos(x, y)
+	}
+}
+
+func (w *window) Position() (x, y int) {
+	return w.window.GetPos()
+}
+
+func (w *window) Minimize() {
+	w.window.Iconify()
+}
+
+func (w *window) Restore() {
+	w.window.Restore()
+}
+
+func (w *window) RequestAttention() {
+	w.window.RequestAttention()
+}
+
+func (w *window) HasFocus() bool {
+	return w.focused
+}
+
+func (w *window) GainedFocus() bool {
+	return w.gainedFocus
+}
+
+func (w *window) LostFocus() bool {
+	return w.lostFocus
+}
+
+func (w *window) Monitors() []Monitor {
+	var monitors []Monitor
+	for i, m := range glfw.GetMonitors() {
+		x, y := m.GetPos()
+		workX, workY, workW, workH := m.GetWorkarea()
+		current := m.GetVideoMode()
+		var modes []VideoMode
+		for _, mode := range m.GetVideoModes() {
+			modes = append(modes, VideoMode{mode.Width, mode.Height, mode.RefreshRate})
+		}
+		monitors = append(monitors, Monitor{
+			Name:        m.GetName(),
+			X:           x,
+			Y:           y,
+			Width:       current.Width,
+			Height:      current.Height,
+			WorkX:       workX,
+			WorkY:       workY,
+			WorkWidth:   workW,
+			WorkHeight:  workH,
+			Primary:     i == 0, // GLFW always lists the primary monitor first.
+			CurrentMode: VideoMode{current.Width, current.Height, current.RefreshRate},
+			Modes:       sortVideoModes(modes),
+		})
+	}
+	return monitors
+}
+
 func monitorContaining(winX, winY int) *glfw.Monitor {
 	for _, m := range glfw.GetMonitors() {
 		x, y, w, h := m.GetWorkarea()
@@ -317,8 +740,9 @@ func sign(x int) int {
 }
 
 type texture struct {
-	id   uint32
-	w, h int
+	id        uint32
+	w, h      int
+	ninePatch ninePatchInsets
 }
 
 func (w *window) loadTexture(r io.Reader, name string) (texture, error) {
@@ -327,6 +751,11 @@ func (w *window) loadTexture(r io.Reader, name string) (texture, error) {
 		return texture{}, err
 	}
 
+	var ninePatch ninePatchInsets
+	if isNinePatchFile(name) {
+		ninePatch, img = readNinePatch(img)
+	}
+
 	var nrgba *image.NRGBA
 	if asNRGBA, ok := img.(*image.NRGBA); ok {
 		nrgba = asNRGBA
@@ -385,9 +814,10 @@ func (w *window) loadTexture(r io.Reader, name string) (texture, error) {
 	gl.Disable(gl.TEXTURE_2D)
 
 	w.textures[name] = texture{
-		id: tex,
-		w:  nrgba.Bounds().Dx(),
-		h:  nrgba.Bounds().Dy(),
+		id:        tex,
+		w:         nrgba.Bounds().Dx(),
+		h:         nrgba.Bounds().Dy(),
+		ninePatch: ninePatch,
 	}
 
 	return w.textures[name], nil
@@ -412,6 +842,14 @@ func (w *window) cleanUp() {
 		gl.DeleteTextures(1, &tex.id)
 	}
 	w.textures = nil
+	w.deleteRenderTarget()
+	if w.pixels.id != 0 {
+		gl.DeleteTextures(1, &w.pixels.id)
+	}
+	for _, tex := range w.fontTextures {
+		gl.DeleteTextures(1, &tex.id)
+	}
+	w.fontTextures = nil
 }
 
 func (w *window) Clicks() []MouseClick {
@@ -422,20 +860,31 @@ func (w *window) Characters() string {
 	return string(w.typed)
 }
 
+func (w *window) ClipboardText() string {
+	return w.window.GetClipboardString()
+}
+
+func (w *window) SetClipboardText(text string) {
+	w.window.SetClipboardString(text)
+}
+
 func (w *window) mouseButtonEvent(win *glfw.Window, button glfw.MouseButton, action glfw.Action, mod glfw.ModifierKey) {
 	if action == glfw.Press {
 		b := toMouseButton(button)
-		x, y := w.window.GetCursorPos()
-		w.clicks = append(w.clicks, MouseClick{X: int(x), Y: int(y), Button: b})
+		x, y := w.toDrawing(w.window.GetCursorPos())
+		windowW, windowH := w.windowSize()
+		vx, vy := w.virtual.toVirtual(x, y, windowW, windowH)
+		w.clicks = append(w.clicks, MouseClick{X: vx, Y: vy, Button: b})
 	}
 }
 
 func (w *window) mousePositionChanged(_ *glfw.Window, x, y float64) {
-	w.mouseX, w.mouseY = int(x+0.5), int(y+0.5)
+	w.mouseX, w.mouseY = w.toDrawing(x, y)
 }
 
 func (w *window) MousePosition() (int, int) {
-	return w.mouseX, w.mouseY
+	windowW, windowH := w.windowSize()
+	return w.virtual.toVirtual(w.mouseX, w.mouseY, windowW, windowH)
 }
 
 func (w *window) MouseWheelY() float64 {
@@ -541,6 +990,10 @@ func (w *window) DrawImageFileRotated(path string, x, y, degrees int) error {
 }
 
 func (w *window) DrawImageFileTo(path string, x, y, width, height, degrees int) error {
+	return w.DrawImageFileTinted(path, x, y, width, height, degrees, White)
+}
+
+func (w *window) DrawImageFileTinted(path string, x, y, width, height, degrees int, tint Color) error {
 	tex, err := w.getOrLoadTexture(path)
 	if err != nil {
 		return err
@@ -580,19 +1033,16 @@ func (w *window) DrawImageFileTo(path string, x, y, width, height, degrees int)
 
 	gl.Begin(gl.QUADS)
 
-	gl.Color4f(1, 1, 1, 1)
+	gl.Color4f(tint.R, tint.G, tint.B, tint.A)
 	gl.TexCoord2i(0, 0)
 	gl.Vertex2f(p[0].x, p[0].y)
 
-	gl.Color4f(1, 1, 1, 1)
 	gl.TexCoord2i(1, 0)
 	gl.Vertex2f(p[1].x, p[1].y)
 
-	gl.Color4f(1, 1, 1, 1)
 	gl.TexCoord2i(1, 1)
 	gl.Vertex2f(p[2].x, p[2].y)
 
-	gl.Color4f(1, 1, 1, 1)
 	gl.TexCoord2i(0, 1)
 	gl.Vertex2f(p[3].x, p[3].y)
 
@@ -670,32 +1120,228 @@ func (w *window) DrawImageFilePart(
 	return nil
 }
 
+func (w *window) PixelAt(x, y int) Color {
+	width, height := w.Size()
+	if x < 0 || y < 0 || x >= width || y >= height {
+		return Color{}
+	}
+
+	// OpenGL rows go bottom-up. We draw in immediate mode so there is nothing
+	// left to flush, ReadPixels waits for the drawing to finish.
+	readX, readY := x, height-1-y
+	if !w.virtualTarget.bound && (w.fbWidth != width || w.fbHeight != height) {
+		// Without HighDPI, the framebuffer might be larger than the drawing
+		// area.
+		readX = x * w.fbWidth / width
+		readY = w.fbHeight - 1 - y*w.fbHeight/height
+	}
+
+	var pixel [4]byte
+	gl.ReadPixels(
+		int32(readX), int32(readY),
+		1, 1,
+		gl.RGBA,
+		gl.UNSIGNED_BYTE,
+		gl.Ptr(&pixel[0]),
+	)
+	return RGB(
+		float32(pixel[0])/255,
+		float32(pixel[1])/255,
+		float32(pixel[2])/255,
+	)
+}
+
 type pointf struct{ x, y float32 }
 
+// pixelTexture is the streaming texture for DrawPixels. It only ever grows, the
+// images are uploaded to its top-left corner.
+type pixelTexture struct {
+	id            uint32
+	width, height int
+}
+
+func (w *window) DrawPixels(x, y int, img *image.RGBA) {
+	w.DrawPixelsTo(x, y, img.Rect.Dx(), img.Rect.Dy(), img)
+}
+
+func (w *window) DrawPixelsTo(x, y, width, height int, img *image.RGBA) {
+	imgW, imgH := img.Rect.Dx(), img.Rect.Dy()
+	if imgW <= 0 || imgH <= 0 {
+		return
+	}
+
+	// The texture is one pixel larger than the image so we can repeat the
+	// image's last column and row next to it. Otherwise blurring would blend
+	// in old pixels at the image's right and bottom edges.
+	gl.Enable(gl.TEXTURE_2D)
+	if w.pixels.id == 0 || imgW+1 > w.pixels.width || imgH+1 > w.pixels.height {
+		if w.pixels.id != 0 {
+			gl.DeleteTextures(1, &w.pixels.id)
+		}
+		w.pixels.width = maxInt(imgW+1, w.pixels.width)
+		w.pixels.height = maxInt(imgH+1, w.pixels.height)
+		gl.GenTextures(1, &w.pixels.id)
+		gl.BindTexture(gl.TEXTURE_2D, w.pixels.id)
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+		gl.TexImage2D(
+			gl.TEXTURE_2D,
+			0,
+			gl.RGBA,
+			int32(w.pixels.width),
+			int32(w.pixels.height),
+			0,
+			gl.RGBA,
+			gl.UNSIGNED_BYTE,
+			nil,
+		)
+	}
+	gl.BindTexture(gl.TEXTURE_2D, w.pixels.id)
+
+	// The image rows might have gaps between them, e.g. for a sub-image.
+	gl.PixelStorei(gl.UNPACK_ROW_LENGTH, int32(img.Stride/4))
+	upload := func(x, y, width, height, srcX, srcY int) {
+		gl.TexSubImage2D(
+			gl.TEXTURE_2D,
+			0,
+			int32(x), int32(y),
+			int32(width), int32(height),
+			gl.RGBA,
+			gl.UNSIGNED_BYTE,
+			gl.Ptr(&img.Pix[img.PixOffset(img.Rect.Min.X+srcX, img.Rect.Min.Y+srcY)]),
+		)
+	}
+	upload(0, 0, imgW, imgH, 0, 0)
+	upload(imgW, 0, 1, imgH, imgW-1, 0)
+	upload(0, imgH, imgW, 1, 0, imgH-1)
+	upload(imgW, imgH, 1, 1, imgW-1, imgH-1)
+	gl.PixelStorei(gl.UNPACK_ROW_LENGTH, 0)
+
+	// There are no mipmaps for the streaming texture.
+	if w.blurImages {
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+	} else {
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.NEAREST)
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.NEAREST)
+	}
+
+	u := float32(imgW) / float32(w.pixels.width)
+	v := float32(imgH) / float32(w.pixels.height)
+	x1, y1 := float32(x), float32(y)
+	x2, y2 := float32(x+width), float32(y+height)
+
+	// The image colors are premultiplied with alpha.
+	gl.BlendFunc(gl.ONE, gl.ONE_MINUS_SRC_ALPHA)
+
+	gl.Begin(gl.QUADS)
+	gl.Color4f(1, 1, 1, 1)
+	gl.TexCoord2f(0, 0)
+	gl.Vertex2f(x1, y1)
+	gl.TexCoord2f(u, 0)
+	gl.Vertex2f(x2, y1)
+	gl.TexCoord2f(u, v)
+	gl.Vertex2f(x2, y2)
+	gl.TexCoord2f(0, v)
+	gl.Vertex2f(x1, y2)
+	gl.End()
+
+	gl.BlendFunc(gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA)
+	gl.Disable(gl.TEXTURE_2D)
+}
+
 func (w *window) BlurImages(blur bool) {
 	w.blurImages = blur
 }
 
+func (w *window) DrawNinePatch(
+	path string,
+	insetLeft, insetTop, insetRight, insetBottom int,
+	x, y, width, height int,
+) error {
+	tex, err := w.getOrLoadTexture(path)
+	if err != nil {
+		return err
+	}
+
+	insets := ninePatchInsets{insetLeft, insetTop, insetRight, insetBottom}
+	if isNinePatchFile(path) {
+		insets = tex.ninePatch
+	}
+	parts := ninePatchParts(tex.w, tex.h, insets, x, y, width, height, w.tileNinePatch)
+	if len(parts) == 0 {
+		return nil
+	}
+
+	gl.Enable(gl.TEXTURE_2D)
+	gl.BindTexture(gl.TEXTURE_2D, tex.id)
+
+	if w.blurImages {
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR)
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+	} else {
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.NEAREST)
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.NEAREST)
+	}
+
+	gl.Begin(gl.QUADS)
+	gl.Color4f(1, 1, 1, 1)
+	for _, p := range parts {
+		srcX, srcY, srcW, srcH := p.texRect(w.blurImages)
+		u0 := srcX / float32(tex.w)
+		u1 := (srcX + srcW) / float32(tex.w)
+		v0 := srcY / float32(tex.h)
+		v1 := (srcY + srcH) / float32(tex.h)
+		x0, y0 := int32(p.destX), int32(p.destY)
+		x1, y1 := int32(p.destX+p.destW), int32(p.destY+p.destH)
+
+		gl.TexCoord2f(u0, v0)
+		gl.Vertex2i(x0, y0)
+
+		gl.TexCoord2f(u1, v0)
+		gl.Vertex2i(x1, y0)
+
+		gl.TexCoord2f(u1, v1)
+		gl.Vertex2i(x1, y1)
+
+		gl.TexCoord2f(u0, v1)
+		gl.Vertex2i(x0, y1)
+	}
+	gl.End()
+	gl.Disable(gl.TEXTURE_2D)
+
+	return nil
+}
+
+func (w *window) TileNinePatches(tile bool) {
+	w.tileNinePatch = tile
+}
+
+func (w *window) SetFont(font *Font) {
+	w.font = font
+}
+
+func (w *window) SetTextOutline(width int, color Color) {
+	w.textEffects.outlineWidth = width
+	w.textEffects.outlineColor = color
+}
+
+func (w *window) SetTextShadow(dx, dy int, color Color) {
+	w.textEffects.shadowX = dx
+	w.textEffects.shadowY = dy
+	w.textEffects.shadowColor = color
+}
+
 func (w *window) GetTextSize(text string) (width, height int) {
 	return w.GetScaledTextSize(text, 1.0)
 }
 
 func (w *window) GetScaledTextSize(text string, scale float32) (width, height int) {
-	scale *= fontBaseScale
-	lines := strings.Split(text, "\n")
-	maxLineW := 0
-	for _, line := range lines {
-		w := utf8.RuneCountInString(line)
-		if w > maxLineW {
-			maxLineW = w
-		}
+	if w.font != nil {
+		return w.font.textSize(text, scale)
 	}
 
-	charW := fontCharW - 2*fontGlyphMargin
-	charH := fontCharH - 2*fontGlyphMargin
-	width = int(float32(charW*maxLineW)*scale*fontKerningFactor + 0.5)
-	height = int(float32(charH*len(lines))*scale + 0.5)
-	return width, height
+	return builtinTextSize(text, scale)
 }
 
 func (w *window) DrawText(text string, x, y int, color Color) {
@@ -706,8 +1352,38 @@ func (w *window) DrawScaledText(text string, x, y int, scale float32, color Colo
 	if len(text) == 0 || scale <= 0 {
 		return
 	}
+	w.textEffects.draw(text, x, y, scale, color, w.drawPlainText, w.drawTextOutline)
+}
+
+func (w *window) DrawTransformedText(
+	text string,
+	x, y int,
+	anchorX, anchorY float32,
+	scaleX, scaleY float32,
+	rotationCWDeg int,
+	color Color,
+) {
+	if len(text) == 0 || scaleX == 0 || scaleY == 0 {
+		return
+	}
+	width, height := w.GetTextSize(text)
+	t := newTextTransform(x, y, width, height, anchorX, anchorY, scaleX, scaleY, rotationCWDeg)
+
+	gl.PushMatrix()
+	gl.Translatef(t.x, t.y, 0)
+	gl.Rotatef(float32(t.rotationCWDeg), 0, 0, 1)
+	gl.Scalef(t.scaleX, t.scaleY, 1)
+	gl.Translatef(-t.anchorX, -t.anchorY, 0)
+	w.DrawText(text, 0, 0, color)
+	gl.PopMatrix()
+}
 
-	scale *= fontBaseScale
+// drawPlainText draws the text without outline and shadow.
+func (w *window) drawPlainText(text string, x, y int, scale float32, color Color) {
+	if w.font != nil {
+		w.drawFontText(w.font, text, x, y, scale, color)
+		return
+	}
 
 	fontTextureW := 16 * fontCharW
 	fontTextureH := 16 * fontCharH
@@ -718,9 +1394,12 @@ func (w *window) DrawScaledText(text string, x, y int, scale float32, color Colo
 	uSize := float32(fontCharW-2*fontGlyphMargin) / float32(fontTextureW)
 	vSize := float32(fontCharH-2*fontGlyphMargin) / float32(fontTextureH)
 
-	width := float32(fontCharW-2*fontGlyphMargin) * scale * fontKerningFactor
-	height := float32(fontCharH-2*fontGlyphMargin) * scale
-	destX, destY := float32(x), float32(y)
+	width, height := builtinCellSize(scale)
+
+	// Rasterize all glyphs that are not in the font bitmap before uploading
+	// the fallback atlas.
+	fallback := w.builtinFallback()
+	layoutBuiltinText(fallback, text, 0, 0, scale, nil, nil)
 
 	fontTexture, _ := w.textures[fontTextureID]
 	gl.Enable(gl.TEXTURE_2D)
@@ -729,14 +1408,7 @@ func (w *window) DrawScaledText(text string, x, y int, scale float32, color Colo
 	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
 
 	gl.Begin(gl.QUADS)
-	for _, r := range text {
-		if r == '\n' {
-			destX = float32(x)
-			destY += height
-			continue
-		}
-
-		index := runeToFont(r)
+	layoutBuiltinText(fallback, text, float32(x), float32(y), scale, func(index rune, destX, destY float32) {
 		u := uOffset + float32(index%16)*uStep
 		v := vOffset + float32(index/16)*vStep
 
@@ -755,13 +1427,183 @@ func (w *window) DrawScaledText(text string, x, y int, scale float32, color Colo
 		gl.Color4f(color.R, color.G, color.B, color.A)
 		gl.TexCoord2f(u, v+vSize)
 		gl.Vertex2f(destX, destY+height)
+	}, nil)
+	gl.End()
+
+	// Characters that are not in the font bitmap are drawn in a second pass
+	// with the fallback font's atlas.
+	if fallback != nil && fallback.version > 0 {
+		w.bindFontTexture(&fallback.glyphAtlas)
+		gl.Begin(gl.QUADS)
+		gl.Color4f(color.R, color.G, color.B, color.A)
+		layoutBuiltinText(fallback, text, float32(x), float32(y), scale, nil, func(g glyph, x, y, width, height float32) {
+			fontGlyphQuad(&fallback.glyphAtlas, g, x, y, width, height)
+		})
+		gl.End()
+	}
+	gl.Disable(gl.TEXTURE_2D)
+}
 
-		destX += width
+// drawTextOutline draws the outline of the text, which is outlineWidth pixels
+// wide at scale 1.
+func (w *window) drawTextOutline(text string, x, y int, scale float32, outlineWidth int, color Color) {
+	if w.font != nil {
+		outlines, quads := w.font.outlineQuads(text, float32(x), float32(y), scale, outlineWidth)
+		w.drawGlyphQuads(&outlines.glyphAtlas, quads, color)
+		return
+	}
+
+	bitmapOutlines, bitmapQuads, fallbackOutlines, fallbackQuads := builtinOutlineQuads(
+		w.builtinFallback(), text, float32(x), float32(y), scale, outlineWidth,
+	)
+	w.drawGlyphQuads(&bitmapOutlines.glyphAtlas, bitmapQuads, color)
+	if fallbackOutlines != nil {
+		w.drawGlyphQuads(&fallbackOutlines.glyphAtlas, fallbackQuads, color)
+	}
+}
+
+// drawGlyphQuads draws the glyphs from the atlas in a solid color.
+func (w *window) drawGlyphQuads(atlas *glyphAtlas, quads []glyphQuad, color Color) {
+	if len(quads) == 0 {
+		return
+	}
+	gl.Enable(gl.TEXTURE_2D)
+	w.bindFontTexture(atlas)
+	gl.Begin(gl.QUADS)
+	gl.Color4f(color.R, color.G, color.B, color.A)
+	for _, q := range quads {
+		fontGlyphQuad(atlas, q.g, q.x, q.y, q.width, q.height)
 	}
 	gl.End()
 	gl.Disable(gl.TEXTURE_2D)
 }
 
+// builtinFallback returns the font for characters that are not in the font
+// bitmap, creating it on first use.
+func (w *window) builtinFallback() *Font {
+	if w.fallbackFont == nil {
+		w.fallbackFont = newBuiltinFallback()
+	}
+	return w.fallbackFont
+}
+
+func (w *window) AddFallbackFont(path string) error {
+	data, err := ReadFile(path)
+	if err != nil {
+		return err
+	}
+	fallback := w.builtinFallback()
+	if fallback == nil {
+		return errors.New("the built-in fallback font could not be created")
+	}
+	return fallback.ParseFallback(data)
+}
+
+// fontTexture is an uploaded glyph atlas. The atlas is uploaded again
+// when its version changes.
+type fontTexture struct {
+	id      uint32
+	version int
+}
+
+func (w *window) drawFontText(font *Font, text string, x, y int, scale float32, color Color) {
+	// Rasterize all glyphs before uploading the atlas.
+	font.textSize(text, 1)
+
+	gl.Enable(gl.TEXTURE_2D)
+	w.bindFontTexture(&font.glyphAtlas)
+	gl.Begin(gl.QUADS)
+	gl.Color4f(color.R, color.G, color.B, color.A)
+	font.layout(text, float32(x), float32(y), scale, func(g glyph, x, y, width, height float32) {
+		fontGlyphQuad(&font.glyphAtlas, g, x, y, width, height)
+	})
+	gl.End()
+	gl.Disable(gl.TEXTURE_2D)
+}
+
+// bindFontTexture binds the glyph atlas texture, uploading it if it changed.
+func (w *window) bindFontTexture(atlas *glyphAtlas) {
+	tex, ok := w.fontTextures[atlas]
+	if !ok {
+		gl.GenTextures(1, &tex.id)
+		gl.BindTexture(gl.TEXTURE_2D, tex.id)
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+		tex.version = -1
+	}
+	gl.BindTexture(gl.TEXTURE_2D, tex.id)
+	if tex.version != atlas.version {
+		gl.TexImage2D(
+			gl.TEXTURE_2D,
+			0,
+			gl.RGBA,
+			int32(atlas.atlas.Rect.Dx()),
+			int32(atlas.atlas.Rect.Dy()),
+			0,
+			gl.RGBA,
+			gl.UNSIGNED_BYTE,
+			gl.Ptr(atlas.atlas.Pix),
+		)
+		gl.GenerateMipmap(gl.TEXTURE_2D)
+		tex.version = atlas.version
+		if w.fontTextures == nil {
+			w.fontTextures = make(map[*glyphAtlas]fontTexture)
+		}
+		w.fontTextures[atlas] = tex
+	}
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+}
+
+// fontGlyphQuad adds the vertices for a glyph from the atlas between
+// gl.Begin(gl.QUADS) and gl.End.
+func fontGlyphQuad(atlas *glyphAtlas, g glyph, x, y, width, height float32) {
+	atlasW := float32(atlas.atlas.Rect.Dx())
+	atlasH := float32(atlas.atlas.Rect.Dy())
+	u1, v1 := float32(g.x)/atlasW, float32(g.y)/atlasH
+	u2 := float32(g.x+g.width) / atlasW
+	v2 := float32(g.y+g.height) / atlasH
+
+	gl.TexCoord2f(u1, v1)
+	gl.Vertex2f(x, y)
+	gl.TexCoord2f(u2, v1)
+	gl.Vertex2f(x+width, y)
+	gl.TexCoord2f(u2, v2)
+	gl.Vertex2f(x+width, y+height)
+	gl.TexCoord2f(u1, v2)
+	gl.Vertex2f(x, y+height)
+}
+
+func (w *window) DrawRichText(text string, x, y int, color Color) {
+	w.DrawScaledRichText(text, x, y, 1, color)
+}
+
+func (w *window) DrawScaledRichText(text string, x, y int, scale float32, color Color) {
+	drawRichText(
+		text, x, y, scale, color, w.GetScaledTextSize,
+		w.textEffects, w.drawPlainText, w.drawTextOutline,
+	)
+}
+
+func (w *window) GetRichTextSize(text string) (int, int) {
+	return w.GetScaledRichTextSize(text, 1)
+}
+
+func (w *window) GetScaledRichTextSize(text string, scale float32) (int, int) {
+	_, width, height := layoutRichText(text, scale, White, w.GetScaledTextSize)
+	return width, height
+}
+
+func (w *window) DrawTextBox(text string, x, y, width, height int, align TextAlign, color Color) {
+	for _, line := range layoutTextBox(text, width, height, align, w.GetTextSize) {
+		w.DrawText(line.text, x+line.x, y+line.y, color)
+	}
+}
+
+func (w *window) GetTextBoxSize(text string, width int) (int, int) {
+	return textBoxSize(text, width, w.GetTextSize)
+}
+
 func (w *window) PlaySoundFile(path string) error {
 	return playSoundFile(path)
 }
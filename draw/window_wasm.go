@@ -5,21 +5,18 @@ package draw
 
 import (
 	"fmt"
+	"image"
 	"io"
 	"math"
 	"strings"
 	"syscall/js"
 	"time"
-
-	_ "embed"
 )
 
-//go:embed Go-Mono.ttf
-var fontData []byte
-
 type wasmWindow struct {
 	canvas           js.Value
 	ctx              js.Value
+	screenCtx        js.Value
 	width            int
 	height           int
 	running          bool
@@ -27,6 +24,7 @@ type wasmWindow struct {
 	keyDown          [keyCount]bool
 	pressedKeys      []Key
 	typed            string
+	clipboard        string
 	mouseX           int
 	mouseY           int
 	mouseDown        [mouseButtonCount]bool
@@ -42,11 +40,52 @@ type wasmWindow struct {
 	hasSeenUserInput bool
 	soundsToPlay     []futureSound
 	iconPath         string
+	tileNinePatch    bool
+	virtual          virtualScreen
+	virtualCanvas    js.Value
+	drawingVirtual   bool
+	clearColor       Color
+	frameRate        int
+	timer            frameTimer
+	stats            frameStatsCollector
+	windowedWidth    int
+	windowedHeight   int
+	resizable        bool
+	resized          bool
+	minWidth         int
+	minHeight        int
+	maxWidth         int
+	maxHeight        int
+	focused          bool
+	gainedFocus      bool
+	lostFocus        bool
+	pixelCanvas      js.Value
+	pixelBuffer      []byte
+	pixelData        js.Value
+	tintCanvas       js.Value
+	font             *Font
+	fontAtlases      map[*glyphAtlas]*fontAtlas
+	fontFamily       string
+	textEffects      textEffects
+}
+
+// fontAtlas holds a glyph atlas in a canvas. The canvas can only be drawn with
+// a single color so we keep a tinted copy for each text color that we use.
+type fontAtlas struct {
+	canvas  js.Value
+	version int
+	tinted  map[Color]js.Value
 }
 
+// maxTintedFontAtlases limits the number of tinted atlas copies that we keep
+// per atlas. When it is reached, all are thrown away and created again as
+// needed.
+const maxTintedFontAtlases = 16
+
 type imageState struct {
-	image js.Value
-	err   error
+	image     js.Value
+	err       error
+	ninePatch ninePatchInsets
 }
 
 type futureSound struct {
@@ -54,30 +93,45 @@ type futureSound struct {
 	startedAt time.Time
 }
 
-func RunWindow(title string, width, height int, update UpdateFunction) error {
+func RunWindowWithOptions(opts WindowOptions, update UpdateFunction) error {
+	opts = opts.withDefaults()
+	width, height := opts.Width, opts.Height
+
 	doc := js.Global().Get("document")
-	doc.Set("title", title)
-	canvas := doc.Call("getElementById", "gameCanvas")
+	doc.Set("title", opts.Title)
+	canvas := doc.Call("getElementById", opts.CanvasID)
 	if !canvas.Truthy() {
-		return js.Error{Value: js.ValueOf("canvas element not found")}
+		return js.Error{Value: js.ValueOf("canvas element not found: " + opts.CanvasID)}
 	}
 	canvas.Set("width", width)
 	canvas.Set("height", height)
 
 	window := &wasmWindow{
-		running:       true,
-		width:         width,
-		height:        height,
-		showingCursor: true,
-		canvas:        canvas,
-		ctx:           canvas.Call("getContext", "2d"),
-		audioCtx:      js.Global().Get("AudioContext").New(),
-		images:        map[string]*imageState{},
-		audioBuffers:  map[string]js.Value{},
+		running:        true,
+		width:          width,
+		height:         height,
+		windowedWidth:  width,
+		windowedHeight: height,
+		showingCursor:  true,
+		canvas:         canvas,
+		ctx:            canvas.Call("getContext", "2d"),
+		screenCtx:      canvas.Call("getContext", "2d"),
+		audioCtx:       js.Global().Get("AudioContext").New(),
+		images:         map[string]*imageState{},
+		audioBuffers:   map[string]js.Value{},
+		resizable:      opts.Resizable,
+		wantFullscreen: opts.Fullscreen,
+		clearColor:     opts.ClearColor,
+		frameRate:      opts.FrameRate,
+		timer:          newFrameTimer(),
 	}
 
 	defer window.ShowCursor(true)
 
+	window.updateCanvasSize()
+	window.resized = false
+	window.focused = doc.Call("hasFocus").Bool()
+
 	bindEvent(js.Global(), "keydown", func(e js.Value) {
 		if !window.running {
 			return
@@ -89,13 +143,20 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 		keyValue := e.Get("key").String()
 		key := toKey(keyCode, keyValue)
 
-		if key != 0 && !window.keyDown[key] {
+		ctrl := window.keyDown[KeyLeftControl] || window.keyDown[KeyRightControl]
+		if key == KeyV && ctrl && !window.keyDown[key] {
+			// Reading the clipboard is asynchronous. We report the key press
+			// once the text is there, so ClipboardText returns it when the
+			// program sees Ctrl+V.
+			window.readClipboard(func() {
+				window.pressedKeys = append(window.pressedKeys, KeyV)
+			})
+		} else if key != 0 && !window.keyDown[key] {
 			window.pressedKeys = append(window.pressedKeys, key)
 		}
 		window.keyDown[key] = true
 
-		if window.keyDown[KeyLeftControl] || window.keyDown[KeyRightControl] ||
-			window.keyDown[KeyLeftAlt] || window.keyDown[KeyRightAlt] ||
+		if ctrl || window.keyDown[KeyLeftAlt] || window.keyDown[KeyRightAlt] ||
 			preventKeyDownDefault[key] {
 			e.Call("preventDefault")
 		}
@@ -132,9 +193,19 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 			return
 		}
 
+		// The canvas is scaled by CSS in fullscreen and for high-DPI
+		// displays, so we convert from CSS pixels to canvas pixels.
 		bounds := canvas.Call("getBoundingClientRect")
-		window.mouseX = e.Get("clientX").Int() - bounds.Get("left").Int()
-		window.mouseY = e.Get("clientY").Int() - bounds.Get("top").Int()
+		x := e.Get("clientX").Float() - bounds.Get("left").Float()
+		y := e.Get("clientY").Float() - bounds.Get("top").Float()
+		if w := bounds.Get("width").Float(); w > 0 {
+			x *= canvas.Get("width").Float() / w
+		}
+		if h := bounds.Get("height").Float(); h > 0 {
+			y *= canvas.Get("height").Float() / h
+		}
+		window.mouseX = int(math.Floor(x))
+		window.mouseY = int(math.Floor(y))
 	})
 
 	// To determine whether the mouse buttons are currently up or down, we
@@ -172,9 +243,10 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 
 		button := e.Get("button").Int()
 		if 0 <= button && button < int(mouseButtonCount) {
+			x, y := window.MousePosition()
 			window.clicks = append(window.clicks, MouseClick{
-				X:      window.mouseX,
-				Y:      window.mouseY,
+				X:      x,
+				Y:      y,
 				Button: MouseButton(button),
 			})
 		}
@@ -206,43 +278,84 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 
 		window.isFullscreen = doc.Get("fullscreenElement").Truthy()
 		window.wantFullscreen = window.isFullscreen
-		if window.isFullscreen {
-			win := js.Global().Get("window")
-			canvas.Set("width", win.Get("innerWidth"))
-			canvas.Set("height", win.Get("innerHeight"))
-			canvas.Get("style").Set("width", "100vw")
-			canvas.Get("style").Set("height", "100vh")
-		} else {
-			canvas.Set("width", width)
-			canvas.Set("height", height)
-			canvas.Get("style").Set("width", fmt.Sprintf("%dpx", width))
-			canvas.Get("style").Set("height", fmt.Sprintf("%dpx", height))
+		window.updateCanvasSize()
+	})
+
+	bindEvent(js.Global(), "focus", func(e js.Value) {
+		if !window.running {
+			return
+		}
+
+		window.focused = true
+		window.gainedFocus = true
+	})
+
+	bindEvent(js.Global(), "blur", func(e js.Value) {
+		if !window.running {
+			return
+		}
+
+		window.focused = false
+		window.lostFocus = true
+		// We do not get the keyup and mouseup events for keys and buttons that
+		// are released while another window has the focus.
+		window.keyDown = [keyCount]bool{}
+		window.mouseDown = [mouseButtonCount]bool{}
+	})
+
+	bindEvent(js.Global(), "resize", func(e js.Value) {
+		if !window.running {
+			return
 		}
+
+		window.updateCanvasSize()
 	})
 
-	fontArray := js.Global().Get("Uint8Array").New(len(fontData))
-	js.CopyBytesToJS(fontArray, fontData)
+	fontArray := js.Global().Get("Uint8Array").New(len(goMonoTTF))
+	js.CopyBytesToJS(fontArray, goMonoTTF)
 	fontBlob := js.Global().Get("Blob").New(js.ValueOf([]interface{}{fontArray}))
 	window.fontURL = js.Global().Get("URL").Call("createObjectURL", fontBlob)
 	style := js.Global().Get("document").Call("createElement", "style")
 	style.Set("textContent", "@font-face { font-family: '_draw_font_'; src: url('"+window.fontURL.String()+"'); }")
 	js.Global().Get("document").Get("head").Call("appendChild", style)
 	js.Global().Get("document").Get("fonts").Call("load", "1em _draw_font_")
+	window.fontFamily = "_draw_font_"
 
 	// Main render loop using requestAnimationFrame.
+	// requestAnimationFrame calls us at the monitor's refresh rate, we run
+	// update at our own frame rate, see fixedStep.
+	var steps fixedStep
 	var renderFrame js.Func
 	renderFrame = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
-		window.FillRect(0, 0, 99999, 99999, Black)
-		if window.running {
+		if !window.running {
+			window.FillRect(0, 0, 99999, 99999, window.clearColor)
+			return nil
+		}
+
+		now := time.Duration(args[0].Float() * float64(time.Millisecond))
+		updates := steps.frame(now, frameInterval(window.frameRate))
+		frameTime := time.Now()
+		for i := 0; i < updates && window.running; i++ {
+			window.timer.startFrame(frameTime, window.frameRate)
+			window.FillRect(0, 0, 99999, 99999, window.clearColor)
+			window.beginVirtualScreen()
 			update(window)
+			window.endVirtualScreen()
 			// Reset input state between frames.
 			window.wheelX = 0
 			window.wheelY = 0
 			window.clicks = window.clicks[:0]
 			window.pressedKeys = window.pressedKeys[:0]
 			window.typed = ""
-			js.Global().Call("requestAnimationFrame", renderFrame)
+			window.resized = false
+			window.gainedFocus = false
+			window.lostFocus = false
 		}
+		if updates > 0 {
+			window.stats.frameShown(time.Now(), updates)
+		}
+
+		js.Global().Call("requestAnimationFrame", renderFrame)
 		return nil
 	})
 	js.Global().Call("requestAnimationFrame", renderFrame)
@@ -310,6 +423,9 @@ func (w *wasmWindow) loadImage(path string) (js.Value, error) {
 	}
 
 	img.Set("onload", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		if isNinePatchFile(path) {
+			w.images[path].image, w.images[path].ninePatch = cropNinePatch(img)
+		}
 		w.images[path].err = nil
 		return nil
 	}))
@@ -334,6 +450,35 @@ func (w *wasmWindow) loadImage(path string) (js.Value, error) {
 	return imgState.image, imgState.err
 }
 
+// cropNinePatch reads the markers from a loaded .9.png image and returns a
+// canvas with the image's content without the marker border.
+func cropNinePatch(img js.Value) (js.Value, ninePatchInsets) {
+	width, height := img.Get("width").Int(), img.Get("height").Int()
+	if width < 3 || height < 3 {
+		return img, ninePatchInsets{}
+	}
+
+	doc := js.Global().Get("document")
+	full := doc.Call("createElement", "canvas")
+	full.Set("width", width)
+	full.Set("height", height)
+	ctx := full.Call("getContext", "2d")
+	ctx.Call("drawImage", img, 0, 0)
+	data := ctx.Call("getImageData", 0, 0, width, height).Get("data")
+	pixels := image.NewNRGBA(image.Rect(0, 0, width, height))
+	js.CopyBytesToGo(pixels.Pix, data)
+	insets, _ := readNinePatch(pixels)
+
+	inner := doc.Call("createElement", "canvas")
+	inner.Set("width", width-2)
+	inner.Set("height", height-2)
+	inner.Call("getContext", "2d").Call("drawImage", img,
+		1, 1, width-2, height-2,
+		0, 0, width-2, height-2,
+	)
+	return inner, insets
+}
+
 func loadBlob(path string) (js.Value, error) {
 	f, err := OpenFile(path)
 	if err != nil {
@@ -566,9 +711,75 @@ func (w *wasmWindow) SetIcon(path string) error {
 }
 
 func (w *wasmWindow) Size() (int, int) {
+	if w.virtual.active() {
+		return w.virtual.width, w.virtual.height
+	}
+	return w.windowSize()
+}
+
+func (w *wasmWindow) windowSize() (int, int) {
 	return w.canvas.Get("width").Int(), w.canvas.Get("height").Int()
 }
 
+func (w *wasmWindow) SetVirtualSize(width, height int, mode ScaleMode) {
+	v := virtualScreen{width: width, height: height, mode: mode}
+	if !v.active() {
+		v = virtualScreen{}
+	}
+	if v == w.virtual {
+		return
+	}
+
+	// The new size takes effect right away. What was drawn so far in this
+	// frame is scaled to the canvas with the old settings.
+	w.endVirtualScreen()
+	w.virtual = v
+	w.beginVirtualScreen()
+}
+
+// beginVirtualScreen redirects all drawing to an offscreen canvas if a
+// virtual size is set.
+func (w *wasmWindow) beginVirtualScreen() {
+	if !w.virtual.active() {
+		return
+	}
+
+	if !w.virtualCanvas.Truthy() {
+		doc := js.Global().Get("document")
+		w.virtualCanvas = doc.Call("createElement", "canvas")
+	}
+	if w.virtualCanvas.Get("width").Int() != w.virtual.width ||
+		w.virtualCanvas.Get("height").Int() != w.virtual.height {
+		w.virtualCanvas.Set("width", w.virtual.width)
+		w.virtualCanvas.Set("height", w.virtual.height)
+	}
+
+	smooth := w.ctx.Get("imageSmoothingEnabled")
+	w.ctx = w.virtualCanvas.Call("getContext", "2d")
+	w.ctx.Set("imageSmoothingEnabled", smooth)
+	w.drawingVirtual = true
+	w.FillRect(0, 0, w.virtual.width, w.virtual.height, w.clearColor)
+}
+
+// endVirtualScreen draws the offscreen canvas, scaled to the real canvas.
+func (w *wasmWindow) endVirtualScreen() {
+	if !w.drawingVirtual {
+		return
+	}
+	w.drawingVirtual = false
+
+	smooth := w.ctx.Get("imageSmoothingEnabled")
+	w.ctx = w.screenCtx
+
+	windowW, windowH := w.windowSize()
+	// The bars around the scaled screen are black, not the clear color.
+	w.FillRect(0, 0, windowW, windowH, Black)
+	x, y, width, height := w.virtual.viewport(windowW, windowH)
+	w.ctx.Set("imageSmoothingEnabled", w.virtual.mode != ScalePixelPerfect)
+	w.ctx.Call("drawImage", w.virtualCanvas, x, y, width, height)
+	w.ctx.Set("imageSmoothingEnabled", smooth)
+}
+
 func (w *wasmWindow) onUserInteraction() {
 	// In the browser, we need a user action to be allowed to go fullscreen
 	// and play sounds so we do this in the key and mouse button handlers.
@@ -590,6 +801,35 @@ func (w *wasmWindow) SetFullscreen(fullscreen bool) {
 	}
 }
 
+func (w *wasmWindow) Monitors() []Monitor {
+	screen := js.Global().Get("screen")
+	width, height := screen.Get("width").Int(), screen.Get("height").Int()
+	// availLeft and availTop are not available in all browsers.
+	workX, workY := 0, 0
+	if left := screen.Get("availLeft"); left.Type() == js.TypeNumber {
+		workX = left.Int()
+	}
+	if top := screen.Get("availTop"); top.Type() == js.TypeNumber {
+		workY = top.Int()
+	}
+	mode := VideoMode{Width: width, Height: height}
+	return []Monitor{{
+		Width:       width,
+		Height:      height,
+		WorkX:       workX,
+		WorkY:       workY,
+		WorkWidth:   screen.Get("availWidth").Int(),
+		WorkHeight:  screen.Get("availHeight").Int(),
+		Primary:     true,
+		CurrentMode: mode,
+		Modes:       []VideoMode{mode},
+	}}
+}
+
+func (w *wasmWindow) SetFullscreenMode(monitor int, mode VideoMode) {
+	w.SetFullscreen(true)
+}
+
 func (w *wasmWindow) updateFullscreen() {
 	if w.isFullscreen != w.wantFullscreen {
 		if w.wantFullscreen {
@@ -600,6 +840,133 @@ func (w *wasmWindow) updateFullscreen() {
 	}
 }
 
+func (w *wasmWindow) SetFrameRate(framesPerSecond int) {
+	w.frameRate = normalizeFrameRate(framesPerSecond)
+}
+
+func (w *wasmWindow) DeltaTime() float64 {
+	return w.timer.deltaTime()
+}
+
+func (w *wasmWindow) FrameCount() int {
+	return w.timer.frameCount()
+}
+
+func (w *wasmWindow) FPS() float64 {
+	return w.timer.fps
+}
+
+func (w *wasmWindow) FrameStats() FrameStats {
+	return w.stats.stats
+}
+
+func (w *wasmWindow) ContentScale() float32 {
+	scale := js.Global().Get("devicePixelRatio")
+	if scale.Truthy() {
+		return float32(scale.Float())
+	}
+	return 1
+}
+
+func (w *wasmWindow) SetResizable(resizable bool) {
+	if resizable == w.resizable {
+		return
+	}
+	w.resizable = resizable
+	w.updateCanvasSize()
+}
+
+func (w *wasmWindow) WasResized() bool {
+	return w.resized
+}
+
+func (w *wasmWindow) SetSizeLimits(minWidth, minHeight, maxWidth, maxHeight int) {
+	w.minWidth, w.minHeight = minWidth, minHeight
+	w.maxWidth, w.maxHeight = maxWidth, maxHeight
+	w.updateCanvasSize()
+}
+
+func (w *wasmWindow) SetTitle(title string) {
+	js.Global().Get("document").Set("title", title)
+}
+
+func (w *wasmWindow) SetPosition(x, y int) {}
+
+func (w *wasmWindow) Position() (x, y int) {
+	return 0, 0
+}
+
+func (w *wasmWindow) Minimize() {}
+
+func (w *wasmWindow) Restore() {}
+
+func (w *wasmWindow) RequestAttention() {}
+
+func (w *wasmWindow) HasFocus() bool {
+	return w.focused
+}
+
+func (w *wasmWindow) GainedFocus() bool {
+	return w.gainedFocus
+}
+
+func (w *wasmWindow) LostFocus() bool {
+	return w.lostFocus
+}
+
+// updateCanvasSize sizes the canvas to fill the screen in fullscreen mode,
+// to fill the browser window if it is resizable and to its original size
+// otherwise.
+func (w *wasmWindow) updateCanvasSize() {
+	width, height := w.windowedWidth, w.windowedHeight
+	style := w.canvas.Get("style")
+
+	if w.isFullscreen || w.resizable {
+		win := js.Global().Get("window")
+		width, height = win.Get("innerWidth").Int(), win.Get("innerHeight").Int()
+	}
+
+	if w.isFullscreen {
+		style.Set("width", "100vw")
+		style.Set("height", "100vh")
+	} else {
+		if w.resizable {
+			width = limitSize(width, w.minWidth, w.maxWidth)
+			height = limitSize(height, w.minHeight, w.maxHeight)
+		}
+		style.Set("width", fmt.Sprintf("%dpx", width))
+		style.Set("height", fmt.Sprintf("%dpx", height))
+	}
+
+	// The sizes so far are in CSS pixels. For high-DPI we make the canvas
+	// have as many pixels as the screen area that it covers.
+	if HighDPI {
+		scale := float64(w.ContentScale())
+		width = int(math.Round(float64(width) * scale))
+		height = int(math.Round(float64(height) * scale))
+	}
+
+	if width != w.canvas.Get("width").Int() || height != w.canvas.Get("height").Int() {
+		// Resizing the canvas resets the context state.
+		smooth := w.screenCtx.Get("imageSmoothingEnabled")
+		w.canvas.Set("width", width)
+		w.canvas.Set("height", height)
+		w.screenCtx.Set("imageSmoothingEnabled", smooth)
+		w.resized = true
+	}
+}
+
+// limitSize clamps size to the given limits, a limit <= 0 means no limit.
+func limitSize(size, low, high int) int {
+	if high > 0 && size > high {
+		size = high
+	}
+	if low > 0 && size < low {
+		size = low
+	}
+	return size
+}
+
 func (w *wasmWindow) ShowCursor(show bool) {
 	if show == w.showingCursor {
 		return
@@ -631,6 +998,51 @@ func (w *wasmWindow) Characters() string {
 	return w.typed
 }
 
+func (w *wasmWindow) ClipboardText() string {
+	return w.clipboard
+}
+
+func (w *wasmWindow) SetClipboardText(text string) {
+	w.clipboard = text
+	clipboard := js.Global().Get("navigator").Get("clipboard")
+	if !clipboard.Truthy() || !clipboard.Get("writeText").Truthy() {
+		return
+	}
+	var failed js.Func
+	failed = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		// Without permission the text stays only in our copy.
+		failed.Release()
+		return nil
+	})
+	clipboard.Call("writeText", text).Call("catch", failed)
+}
+
+// readClipboard reads the browser's clipboard text and calls done when it is
+// there. If the browser does not allow reading it, the text stays the same
+// and done is still called.
+func (w *wasmWindow) readClipboard(done func()) {
+	clipboard := js.Global().Get("navigator").Get("clipboard")
+	if !clipboard.Truthy() || !clipboard.Get("readText").Truthy() {
+		done()
+		return
+	}
+	var read, failed js.Func
+	read = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		w.clipboard = args[0].String()
+		done()
+		read.Release()
+		failed.Release()
+		return nil
+	})
+	failed = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		done()
+		read.Release()
+		failed.Release()
+		return nil
+	})
+	clipboard.Call("readText").Call("then", read, failed)
+}
+
 func (w *wasmWindow) IsMouseDown(button MouseButton) bool {
 	return w.mouseDown[button]
 }
@@ -640,7 +1052,8 @@ func (w *wasmWindow) Clicks() []MouseClick {
 }
 
 func (w *wasmWindow) MousePosition() (int, int) {
-	return w.mouseX, w.mouseY
+	windowW, windowH := w.windowSize()
+	return w.virtual.toVirtual(w.mouseX, w.mouseY, windowW, windowH)
 }
 
 func (w *wasmWindow) MouseWheelX() float64 {
@@ -774,12 +1187,21 @@ func (w *wasmWindow) DrawImageFile(path string, x, y int) error {
 }
 
 func (w *wasmWindow) DrawImageFileTo(path string, x, y, width, height, rot int) error {
+	return w.DrawImageFileTinted(path, x, y, width, height, rot, White)
+}
+
+func (w *wasmWindow) DrawImageFileTinted(path string, x, y, width, height, rot int, tint Color) error {
 	img, err := w.loadImage(path)
 	if err != nil {
 		return err
 	}
+	imgW, imgH := img.Get("width").Int(), img.Get("height").Int()
+	if tint.R != 1 || tint.G != 1 || tint.B != 1 {
+		img = w.tintedImage(img, imgW, imgH, tint)
+	}
 
 	w.ctx.Call("save")
+	w.ctx.Set("globalAlpha", tint.A)
 
 	w.ctx.Call("translate", x+width/2, y+height/2)
 	w.ctx.Call("rotate", float64(rot)*math.Pi/180)
@@ -796,7 +1218,7 @@ func (w *wasmWindow) DrawImageFileTo(path string, x, y, width, height, rot int)
 	}
 
 	w.ctx.Call("drawImage", img,
-		0, 0, img.Get("width").Int(), img.Get("height").Int(),
+		0, 0, imgW, imgH,
 		-width/2, -height/2, width, height,
 	)
 
@@ -804,6 +1226,27 @@ func (w *wasmWindow) DrawImageFileTo(path string, x, y, width, height, rot int)
 	return nil
 }
 
+// tintedImage returns a canvas with the image's colors multiplied by the tint,
+// ignoring its alpha. The canvas is re-used for the next tinted image.
+func (w *wasmWindow) tintedImage(img js.Value, width, height int, tint Color) js.Value {
+	if !w.tintCanvas.Truthy() {
+		w.tintCanvas = js.Global().Get("document").Call("createElement", "canvas")
+	}
+	// Setting the size clears the canvas, even if it stays the same.
+	w.tintCanvas.Set("width", width)
+	w.tintCanvas.Set("height", height)
+	ctx := w.tintCanvas.Call("getContext", "2d")
+	ctx.Call("drawImage", img, 0, 0)
+	ctx.Set("globalCompositeOperation", "multiply")
+	ctx.Set("fillStyle", fmt.Sprintf("rgb(%d,%d,%d)",
+		int(tint.R*255), int(tint.G*255), int(tint.B*255)))
+	ctx.Call("fillRect", 0, 0, width, height)
+	// The multiplied rectangle is opaque, this cuts out the image's shape.
+	ctx.Set("globalCompositeOperation", "destination-in")
+	ctx.Call("drawImage", img, 0, 0)
+	return w.tintCanvas
+}
+
 func (w *wasmWindow) DrawImageFileRotated(path string, x, y, rot int) error {
 	img, err := w.loadImage(path)
 	if err != nil {
@@ -853,39 +1296,152 @@ func (w *wasmWindow) DrawImageFilePart(path string,
 	return nil
 }
 
+func (w *wasmWindow) DrawPixels(x, y int, img *image.RGBA) {
+	w.DrawPixelsTo(x, y, img.Rect.Dx(), img.Rect.Dy(), img)
+}
+
+func (w *wasmWindow) DrawPixelsTo(x, y, width, height int, img *image.RGBA) {
+	imgW, imgH := img.Rect.Dx(), img.Rect.Dy()
+	if imgW <= 0 || imgH <= 0 {
+		return
+	}
+
+	// putImageData ignores blending and scaling so we put the pixels into
+	// our own canvas first and draw that like an image. It only ever grows.
+	// The image's last column and row are repeated next to it, so smoothing
+	// does not blend in old pixels at its right and bottom edges.
+	if !w.pixelCanvas.Truthy() {
+		w.pixelCanvas = js.Global().Get("document").Call("createElement", "canvas")
+		w.pixelCanvas.Set("width", 0)
+		w.pixelCanvas.Set("height", 0)
+	}
+	if imgW+1 > w.pixelCanvas.Get("width").Int() || imgH+1 > w.pixelCanvas.Get("height").Int() {
+		w.pixelCanvas.Set("width", maxInt(imgW+1, w.pixelCanvas.Get("width").Int()))
+		w.pixelCanvas.Set("height", maxInt(imgH+1, w.pixelCanvas.Get("height").Int()))
+	}
+
+	// ImageData has straight alpha. We keep it around as long as the image
+	// size stays the same, usually it is drawn every frame.
+	pix, dataW, dataH := pixelRows(img, w.pixelBuffer, true)
+	w.pixelBuffer = pix
+	unpremultiplyPixels(pix)
+	if !w.pixelData.Truthy() ||
+		w.pixelData.Get("width").Int() != dataW ||
+		w.pixelData.Get("height").Int() != dataH {
+		w.pixelData = js.Global().Get("ImageData").New(dataW, dataH)
+	}
+	js.CopyBytesToJS(w.pixelData.Get("data"), pix)
+	w.pixelCanvas.Call("getContext", "2d").Call("putImageData", w.pixelData, 0, 0)
+
+	w.ctx.Call("drawImage", w.pixelCanvas,
+		0, 0, imgW, imgH,
+		x, y, width, height,
+	)
+}
+
+func (w *wasmWindow) PixelAt(x, y int) Color {
+	width, height := w.Size()
+	if x < 0 || y < 0 || x >= width || y >= height {
+		return Color{}
+	}
+
+	// The canvas draws immediately, there is nothing to flush.
+	data := w.ctx.Call("getImageData", x, y, 1, 1).Get("data")
+	var pixel [4]byte
+	js.CopyBytesToGo(pixel[:], data)
+	return RGB(
+		float32(pixel[0])/255,
+		float32(pixel[1])/255,
+		float32(pixel[2])/255,
+	)
+}
+
 func (w *wasmWindow) BlurImages(blur bool) {
 	w.ctx.Set("imageSmoothingEnabled", blur)
 }
 
+func (w *wasmWindow) DrawNinePatch(
+	path string,
+	insetLeft, insetTop, insetRight, insetBottom int,
+	x, y, width, height int,
+) error {
+	img, err := w.loadImage(path)
+	if err != nil {
+		return err
+	}
+
+	insets := ninePatchInsets{insetLeft, insetTop, insetRight, insetBottom}
+	if isNinePatchFile(path) {
+		insets = w.images[path].ninePatch
+	}
+	parts := ninePatchParts(
+		img.Get("width").Int(), img.Get("height").Int(),
+		insets,
+		x, y, width, height,
+		w.tileNinePatch,
+	)
+
+	blur := w.ctx.Get("imageSmoothingEnabled").Bool()
+	for _, p := range parts {
+		srcX, srcY, srcW, srcH := p.texRect(blur)
+		w.ctx.Call("drawImage", img,
+			srcX, srcY, srcW, srcH,
+			p.destX, p.destY, p.destW, p.destH,
+		)
+	}
+	return nil
+}
+
+func (w *wasmWindow) TileNinePatches(tile bool) {
+	w.tileNinePatch = tile
+}
+
 func (w *wasmWindow) GetTextSize(text string) (int, int) {
 	return w.GetScaledTextSize(text, 1.0)
 }
 
-const (
-	wasmFontBaseScale = 13.5
-	fontLineGapScale  = 1.24
-)
-
-func (w *wasmWindow) GetScaledTextSize(text string, scale float32) (wOut, hOut int) {
-	if scale <= 0 {
-		return 0, 0
+func (w *wasmWindow) AddFallbackFont(path string) error {
+	url := js.ValueOf(path)
+	if OpenFile != nil {
+		var err error
+		url, err = loadBlob(path)
+		if err != nil {
+			return err
+		}
 	}
 
-	fontSize := wasmFontBaseScale * float64(scale)
-	w.ctx.Set("font", fmt.Sprintf("%.2fpx _draw_font_", fontSize))
+	// The browser already falls back to system fonts for characters that
+	// are missing in ours. We put the added fonts before those in the list.
+	family := fmt.Sprintf("_draw_fallback_%d_", strings.Count(w.fontFamily, ","))
+	style := js.Global().Get("document").Call("createElement", "style")
+	style.Set("textContent", "@font-face { font-family: '"+family+"'; src: url('"+url.String()+"'); }")
+	js.Global().Get("document").Get("head").Call("appendChild", style)
+	js.Global().Get("document").Get("fonts").Call("load", "1em "+family)
+	w.fontFamily += ", " + family
+	return nil
+}
 
-	lines := strings.Split(text, "\n")
-	maxWidth := 0
+func (w *wasmWindow) SetFont(font *Font) {
+	w.font = font
+}
 
-	for _, line := range lines {
-		width := w.ctx.Call("measureText", line).Get("width").Int()
-		if width > maxWidth {
-			maxWidth = width
-		}
+func (w *wasmWindow) SetTextOutline(width int, color Color) {
+	w.textEffects.outlineWidth = width
+	w.textEffects.outlineColor = color
+}
+
+func (w *wasmWindow) SetTextShadow(dx, dy int, color Color) {
+	w.textEffects.shadowX = dx
+	w.textEffects.shadowY = dy
+	w.textEffects.shadowColor = color
+}
+
+func (w *wasmWindow) GetScaledTextSize(text string, scale float32) (wOut, hOut int) {
+	if w.font != nil {
+		return w.font.textSize(text, scale)
 	}
 
-	lineHeight := fontSize * fontLineGapScale
-	return maxWidth, int(lineHeight*float64(len(lines)) + 0.9999)
+	return builtinTextSize(text, scale)
 }
 
 func (w *wasmWindow) DrawText(text string, x, y int, color Color) {
@@ -896,18 +1452,227 @@ func (w *wasmWindow) DrawScaledText(text string, x, y int, scale float32, color
 	if scale <= 0 {
 		return
 	}
+	w.textEffects.draw(text, x, y, scale, color, w.drawPlainText, w.drawTextOutline)
+}
+
+func (w *wasmWindow) DrawTransformedText(
+	text string,
+	x, y int,
+	anchorX, anchorY float32,
+	scaleX, scaleY float32,
+	rotationCWDeg int,
+	color Color,
+) {
+	if len(text) == 0 || scaleX == 0 || scaleY == 0 {
+		return
+	}
+	width, height := w.GetTextSize(text)
+	t := newTextTransform(x, y, width, height, anchorX, anchorY, scaleX, scaleY, rotationCWDeg)
+
+	w.ctx.Call("save")
+	w.ctx.Call("translate", t.x, t.y)
+	w.ctx.Call("rotate", float64(t.rotationCWDeg)*math.Pi/180)
+	w.ctx.Call("scale", t.scaleX, t.scaleY)
+	w.ctx.Call("translate", -t.anchorX, -t.anchorY)
+	w.DrawText(text, 0, 0, color)
+	w.ctx.Call("restore")
+}
+
+// drawPlainText draws the text without outline and shadow.
+func (w *wasmWindow) drawPlainText(text string, x, y int, scale float32, color Color) {
+	if w.font != nil {
+		w.drawFontText(w.font, text, x, y, scale, color)
+		return
+	}
 
 	w.setColor(color)
+	w.layoutBuiltinText(text, x, y, scale, func(text string, x, baseline float64) {
+		w.ctx.Call("fillText", text, x, baseline)
+	})
+}
+
+// drawTextOutline draws the outline of the text, which is outlineWidth pixels
+// wide at scale 1.
+func (w *wasmWindow) drawTextOutline(text string, x, y int, scale float32, outlineWidth int, color Color) {
+	if w.font != nil {
+		outlines, quads := w.font.outlineQuads(text, float32(x), float32(y), scale, outlineWidth)
+		w.drawGlyphQuads(&outlines.glyphAtlas, quads, color)
+		return
+	}
+
+	w.ctx.Call("save")
+	w.setColor(color)
+	// The stroke is centered on the glyphs' edges, so half of it is outside.
+	// The glyphs themselves are filled with the outline color as well.
+	w.ctx.Set("lineWidth", 2*float64(outlineWidth)*float64(scale))
+	w.ctx.Set("lineJoin", "round")
+	w.layoutBuiltinText(text, x, y, scale, func(text string, x, baseline float64) {
+		w.ctx.Call("strokeText", text, x, baseline)
+		w.ctx.Call("fillText", text, x, baseline)
+	})
+	w.ctx.Call("restore")
+}
+
+// layoutBuiltinText sets the canvas font to the built-in font and calls draw
+// for the parts of the text, with their positions. The parts are placed by
+// the same measurements as on desktop, so text has the same size everywhere.
+// Runes that Go-Mono has are drawn in runs, the browser places them just
+// like we do. Other runes come from fallback fonts with their own advances,
+// they are drawn one by one, centered in the space that we measured for them.
+func (w *wasmWindow) layoutBuiltinText(
+	text string,
+	x, y int,
+	scale float32,
+	draw func(text string, x, baseline float64),
+) {
+	fontSize := builtinFontSize * float64(scale)
+	lineHeight := builtinLineHeight * float64(scale)
+	w.ctx.Set("font", fmt.Sprintf("%.2fpx %s", fontSize, w.fontFamily))
+
+	metrics := builtinMetrics()
+	for i, line := range strings.Split(text, "\n") {
+		baseline := float64(y) + fontSize + float64(i)*lineHeight
+		penX := float64(x)
+		run, runX := "", penX
+		prev := rune(-1)
+		for _, r := range line {
+			penX += float64(builtinKern(prev, r) * scale)
+			prev = r
+			advance := float64(builtinAdvance(r) * scale)
+			if _, ok := metrics.advance(r); ok {
+				if run == "" {
+					runX = penX
+				}
+				run += string(r)
+			} else {
+				if run != "" {
+					draw(run, runX, baseline)
+					run = ""
+				}
+				glyphW := w.ctx.Call("measureText", string(r)).Get("width").Float()
+				draw(string(r), penX+(advance-glyphW)/2, baseline)
+			}
+			penX += advance
+		}
+		if run != "" {
+			draw(run, runX, baseline)
+		}
+	}
+}
+
+func (w *wasmWindow) drawFontText(font *Font, text string, x, y int, scale float32, color Color) {
+	// Rasterize all glyphs before copying the atlas.
+	font.textSize(text, 1)
+
+	tinted := w.tintedAtlas(&font.glyphAtlas, color)
+	w.ctx.Set("globalAlpha", color.A)
+	font.layout(text, float32(x), float32(y), scale, func(g glyph, x, y, width, height float32) {
+		w.ctx.Call("drawImage", tinted,
+			g.x, g.y, g.width, g.height,
+			x, y, width, height,
+		)
+	})
+	w.ctx.Set("globalAlpha", 1)
+}
 
-	fontSize := wasmFontBaseScale * float64(scale)
-	w.ctx.Set("font", fmt.Sprintf("%.2fpx _draw_font_", fontSize))
+// drawGlyphQuads draws the glyphs from the atlas in a solid color.
+func (w *wasmWindow) drawGlyphQuads(atlas *glyphAtlas, quads []glyphQuad, color Color) {
+	if len(quads) == 0 {
+		return
+	}
+	tinted := w.tintedAtlas(atlas, color)
+	w.ctx.Set("globalAlpha", color.A)
+	for _, q := range quads {
+		w.ctx.Call("drawImage", tinted,
+			q.g.x, q.g.y, q.g.width, q.g.height,
+			q.x, q.y, q.width, q.height,
+		)
+	}
+	w.ctx.Set("globalAlpha", 1)
+}
 
-	lineHeight := fontSize * fontLineGapScale
+// tintedAtlas returns a copy of the atlas canvas with all glyphs in the color,
+// ignoring its alpha.
+func (w *wasmWindow) tintedAtlas(a *glyphAtlas, color Color) js.Value {
+	atlas := w.fontAtlas(a)
+	opaque := RGB(color.R, color.G, color.B)
+	tinted, ok := atlas.tinted[opaque]
+	if !ok {
+		if len(atlas.tinted) >= maxTintedFontAtlases {
+			atlas.tinted = make(map[Color]js.Value)
+		}
+		tinted = js.Global().Get("document").Call("createElement", "canvas")
+		tinted.Set("width", a.atlas.Rect.Dx())
+		tinted.Set("height", a.atlas.Rect.Dy())
+		ctx := tinted.Call("getContext", "2d")
+		ctx.Call("drawImage", atlas.canvas, 0, 0)
+		// Keep the glyphs' alpha but replace their color.
+		ctx.Set("globalCompositeOperation", "source-in")
+		ctx.Set("fillStyle", fmt.Sprintf("rgb(%d,%d,%d)",
+			int(color.R*255), int(color.G*255), int(color.B*255)))
+		ctx.Call("fillRect", 0, 0, a.atlas.Rect.Dx(), a.atlas.Rect.Dy())
+		atlas.tinted[opaque] = tinted
+	}
+	return tinted
+}
 
-	lines := strings.Split(text, "\n")
-	for i, line := range lines {
-		w.ctx.Call("fillText", line, x, fontSize+float64(y)+float64(i)*lineHeight)
+// fontAtlas returns the atlas canvas, updated to the atlas' current version.
+func (w *wasmWindow) fontAtlas(a *glyphAtlas) *fontAtlas {
+	if w.fontAtlases == nil {
+		w.fontAtlases = make(map[*glyphAtlas]*fontAtlas)
+	}
+	atlas, ok := w.fontAtlases[a]
+	if !ok {
+		atlas = &fontAtlas{
+			canvas:  js.Global().Get("document").Call("createElement", "canvas"),
+			version: -1,
+		}
+		w.fontAtlases[a] = atlas
 	}
+
+	if atlas.version != a.version {
+		width, height := a.atlas.Rect.Dx(), a.atlas.Rect.Dy()
+		atlas.canvas.Set("width", width)
+		atlas.canvas.Set("height", height)
+		// The atlas has straight alpha, just like ImageData.
+		data := js.Global().Get("Uint8ClampedArray").New(len(a.atlas.Pix))
+		js.CopyBytesToJS(data, a.atlas.Pix)
+		imageData := js.Global().Get("ImageData").New(data, width, height)
+		atlas.canvas.Call("getContext", "2d").Call("putImageData", imageData, 0, 0)
+		atlas.version = a.version
+		atlas.tinted = make(map[Color]js.Value)
+	}
+	return atlas
+}
+
+func (w *wasmWindow) DrawRichText(text string, x, y int, color Color) {
+	w.DrawScaledRichText(text, x, y, 1, color)
+}
+
+func (w *wasmWindow) DrawScaledRichText(text string, x, y int, scale float32, color Color) {
+	drawRichText(
+		text, x, y, scale, color, w.GetScaledTextSize,
+		w.textEffects, w.drawPlainText, w.drawTextOutline,
+	)
+}
+
+func (w *wasmWindow) GetRichTextSize(text string) (int, int) {
+	return w.GetScaledRichTextSize(text, 1)
+}
+
+func (w *wasmWindow) GetScaledRichTextSize(text string, scale float32) (int, int) {
+	_, width, height := layoutRichText(text, scale, White, w.GetScaledTextSize)
+	return width, height
+}
+
+func (w *wasmWindow) DrawTextBox(text string, x, y, width, height int, align TextAlign, color Color) {
+	for _, line := range layoutTextBox(text, width, height, align, w.GetTextSize) {
+		w.DrawText(line.text, x+line.x, y+line.y, color)
+	}
+}
+
+func (w *wasmWindow) GetTextBoxSize(text string, width int) (int, int) {
+	return textBoxSize(text, width, w.GetTextSize)
 }
 
 func (w *wasmWindow) PlaySoundFile(path string) error {
@@ -16,8 +16,8 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 	"unicode/utf16"
-	"unicode/utf8"
 	"unsafe"
 
 	"github.com/gonutz/d3d9"
@@ -42,7 +42,10 @@ var (
 	fontCharW, fontCharH int
 )
 
-func RunWindow(title string, width, height int, update UpdateFunction) error {
+func RunWindowWithOptions(opts WindowOptions, update UpdateFunction) error {
+	opts = opts.withDefaults()
+	width, height := opts.Width, opts.Height
+
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
@@ -82,6 +85,11 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 		textures:      make(map[string]sizedTexture),
 		curFilter:     d3d9.TEXF_NONE,
 		showingCursor: true,
+		resizable:     opts.Resizable,
+		borderless:    opts.Borderless,
+		clearColor:    opts.ClearColor,
+		frameRate:     opts.FrameRate,
+		timer:         newFrameTimer(),
 	}
 
 	defer globalWindow.ShowCursor(true)
@@ -98,11 +106,18 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 	}
 	defer w32.UnregisterClassAtom(atom, w32.GetModuleHandle(""))
 
+	if HighDPI {
+		enableHighDPI()
+		scale := systemContentScale()
+		width = int(float32(width)*scale + 0.5)
+		height = int(float32(height)*scale + 0.5)
+	}
+
 	var windowSize = w32.RECT{Right: int32(width), Bottom: int32(height)}
 	// NOTE MSDN says you cannot pass WS_OVERLAPPED to this function but it
 	// seems to work (on XP and Windows 8.1 at least) in conjuntion with the
 	// other flags
-	if w32.AdjustWindowRect(&windowSize, windowedStyle, false) {
+	if w32.AdjustWindowRect(&windowSize, globalWindow.style(), false) {
 		width = int(windowSize.Width())
 		height = int(windowSize.Height())
 	}
@@ -150,6 +165,21 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 		}
 	}
 
+	// exclusive fullscreen modes might be larger than the desktop
+	for _, m := range globalWindow.Monitors() {
+		for _, mode := range m.Modes {
+			if mode.Width > backBufferWidth {
+				backBufferWidth = mode.Width
+			}
+			if mode.Height > backBufferHeight {
+				backBufferHeight = mode.Height
+			}
+		}
+	}
+
+	globalWindow.backBufferWidth = backBufferWidth
+	globalWindow.backBufferHeight = backBufferHeight
+
 	// move the currently active monitor to the front of the list so it is
 	// picked before the others if it is large enough
 	if activeWindow := w32.GetForegroundWindow(); activeWindow != 0 {
@@ -168,24 +198,35 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 
 	// find the right monitor to display the window on and center the window in
 	// it, if none is found, x,y will simply be 0,0 which is fine in that case
-	refreshRate := 60 // default to 60 Hz in case we cannot query the monitor
+	globalWindow.refreshRate = 60 // default to 60 Hz in case we cannot query the monitor
 	var x, y int
 	for _, m := range monitors {
 		if m.workWidth >= width && m.workHeight >= height {
 			x = m.workLeft + (m.workWidth-width)/2
 			y = m.workTop + (m.workHeight-height)/2
 			if m.refreshRate != 0 {
-				refreshRate = m.refreshRate
+				globalWindow.refreshRate = m.refreshRate
 			}
 			break
 		}
 	}
+	if opts.Positioned {
+		// The position is given for the drawing area, the window border is
+		// to the top-left of it.
+		x = opts.X + int(windowSize.Left)
+		y = opts.Y + int(windowSize.Top)
+	}
+
+	var exStyle uint
+	if opts.AlwaysOnTop {
+		exStyle = w32.WS_EX_TOPMOST
+	}
 
 	window := w32.CreateWindowEx(
-		0,
+		exStyle,
 		syscall.StringToUTF16Ptr("GoPrototypeWindowClass"),
 		nil,
-		windowedStyle,
+		globalWindow.style(),
 		x, y, width, height,
 		0, 0, 0, nil,
 	)
@@ -194,10 +235,12 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 	}
 	defer w32.DestroyWindow(window)
 	globalWindow.handle = window
-	w32.SetWindowText(window, title)
+	w32.SetWindowText(window, opts.Title)
 
 	// hide the console window if double-clicking on the executable
-	hideConsoleWindow()
+	if !opts.ShowConsole {
+		hideConsoleWindow()
+	}
 
 	// enable raw keyboard input which allows us to handle keys like
 	// shift/control/alt
@@ -209,6 +252,11 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 		return errors.New("RegisterRawInputDevices failed")
 	}
 
+	var presentInterval uint32 = d3d9.PRESENT_INTERVAL_ONE // enable VSync
+	if opts.DisableVSync {
+		presentInterval = d3d9.PRESENT_INTERVAL_IMMEDIATE
+	}
+
 	device, presentParams, err := d3d.CreateDevice(
 		d3d9.ADAPTER_DEFAULT,
 		d3d9.DEVTYPE_HAL,
@@ -222,7 +270,7 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 			Windowed:             1,
 			SwapEffect:           d3d9.SWAPEFFECT_COPY, // so Present can use rects
 			HDeviceWindow:        d3d9.HWND(window),
-			PresentationInterval: d3d9.PRESENT_INTERVAL_ONE, // enable VSync
+			PresentationInterval: presentInterval,
 		},
 	)
 	if err != nil {
@@ -265,15 +313,18 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 		return err
 	}
 
-	// we want to update the game with 60 Hz, if the monitor has e.g. 120 Hz, we
-	// need to update every other vsync, in case of 30 Hz we need to update
-	// twice per vsync
-	if 58 <= refreshRate && refreshRate <= 62 {
-		// close enough, treat it like the 60 Hz that we want
-		refreshRate = 60
+	if opts.Fullscreen {
+		globalWindow.SetFullscreen(true)
 	}
-	updatesPerVsync := 60.0 / float32(refreshRate)
-	nextUpdate := updatesPerVsync
+
+	// we want to update the game with e.g. 60 Hz, if the monitor has 120 Hz,
+	// we need to update every other vsync, in case of 30 Hz we need to update
+	// twice per vsync
+	var vsync vsyncSchedule
+
+	// without VSync, Present does not wait for the monitor so we time the
+	// updates ourselves
+	lastFrame := time.Now()
 
 	// TODO right now we just assume that the refresh setting the DX9 gives us
 	// is correct but maybe the user changed some driver setting that we do not
@@ -283,6 +334,12 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 
 	deviceIsLost := false
 	defer setShowCursorCountTo(0)
+	defer globalWindow.restoreDisplayMode()
+
+	// Creating the window sent a WM_SIZE and WM_SETFOCUS, but the first
+	// frame should not report a resize or focus change.
+	globalWindow.resized = false
+	globalWindow.gainedFocus = false
 
 	var msg w32.MSG
 	w32.PeekMessage(&msg, 0, 0, 0, w32.PM_NOREMOVE)
@@ -291,7 +348,22 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 			w32.TranslateMessage(&msg)
 			w32.DispatchMessage(&msg)
 		} else {
+			if opts.DisableVSync {
+				interval := frameInterval(globalWindow.frameRate)
+				if time.Since(lastFrame) < interval {
+					time.Sleep(time.Millisecond)
+					continue
+				}
+				lastFrame = lastFrame.Add(interval)
+				if time.Since(lastFrame) > interval {
+					// we are too far behind, do not try to catch up
+					lastFrame = time.Now()
+				}
+			}
+
 			if deviceIsLost {
+				globalWindow.releaseRenderTarget()
+				globalWindow.releasePixelTexture()
 				_, err = device.Reset(presentParams)
 				if err == nil {
 					deviceIsLost = false
@@ -304,18 +376,24 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 					return err
 				}
 
-				var wasUpdated bool
-				for nextUpdate > 0 {
-					// clear the screen to black before the update
-					w, h := globalWindow.Size()
-					globalWindow.FillRect(0, 0, w, h, Black)
+				updates := 1
+				if !opts.DisableVSync {
+					updates = vsync.updates(globalWindow.frameRate, globalWindow.refreshRate)
+				}
+				now := time.Now()
+				for i := 0; i < updates; i++ {
+					// clear the screen before the update
+					w, h := globalWindow.windowSize()
+					globalWindow.FillRect(0, 0, w, h, globalWindow.clearColor)
+					globalWindow.timer.startFrame(now, globalWindow.frameRate)
+					globalWindow.beginVirtualScreen()
 					globalWindow.updateMouseInfo()
 					update(globalWindow)
+					globalWindow.endVirtualScreen()
 					globalWindow.flushBacklog()
-					wasUpdated = true
-					nextUpdate -= 1
+					// Input events are only reported to the first update.
+					globalWindow.finishFrame()
 				}
-				nextUpdate += updatesPerVsync
 
 				if globalWindow.d3d9Error != nil {
 					return globalWindow.d3d9Error
@@ -324,7 +402,7 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 				if err := device.EndScene(); err != nil {
 					return err
 				}
-				windowW, windowH := globalWindow.Size()
+				windowW, windowH := globalWindow.windowSize()
 				r := &d3d9.RECT{Right: int32(windowW), Bottom: int32(windowH)}
 				if presentErr := device.Present(r, r, 0, nil); presentErr != nil {
 					if presentErr.Code() == d3d9.ERR_DEVICELOST {
@@ -334,8 +412,8 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 					}
 				}
 
-				if wasUpdated {
-					globalWindow.finishFrame()
+				if updates > 0 {
+					globalWindow.stats.frameShown(time.Now(), updates)
 				}
 			}
 		}
@@ -347,6 +425,8 @@ func RunWindow(title string, width, height int, update UpdateFunction) error {
 	for _, tex := range globalWindow.textures {
 		tex.texture.Release()
 	}
+	globalWindow.releaseRenderTarget()
+	globalWindow.releasePixelTexture()
 
 	globalWindow = nil
 	return nil
@@ -381,6 +461,7 @@ type window struct {
 	windowed      w32.WINDOWPLACEMENT
 	showingCursor bool
 	blurImages    bool
+	tileNinePatch bool
 	curFilter     uint32
 	mouse         struct{ x, y int }
 	wheelX        float64
@@ -395,7 +476,47 @@ type window struct {
 	textures      map[string]sizedTexture
 	backlog       []float32
 	backlogType   shape
+	textTexture   string
 	iconPath      string
+	virtual       virtualScreen
+	virtualTarget renderTarget
+	pixels        pixelTexture
+	font          *Font
+	fontVersions  map[*glyphAtlas]int
+	fallbackFont  *Font
+	textEffects   textEffects
+	textTransform *textTransform
+	resizable     bool
+	resized       bool
+	borderless    bool
+	clearColor    Color
+	frameRate     int
+	timer         frameTimer
+	stats         frameStatsCollector
+	focused       bool
+	gainedFocus   bool
+	lostFocus     bool
+	refreshRate   int
+	minWidth      int
+	minHeight     int
+	maxWidth      int
+	maxHeight     int
+	// The back buffer has a fixed size, we cannot draw a window larger than
+	// this.
+	backBufferWidth  int
+	backBufferHeight int
+	// displayModeDevice is the name of the monitor device whose video mode
+	// we changed for exclusive fullscreen. It is empty if we did not.
+	displayModeDevice [w32.CCHDEVICENAME]uint16
+}
+
+// renderTarget is an offscreen texture that we draw to when a virtual size is
+// set. At the end of the frame it is scaled to the back buffer.
+type renderTarget struct {
+	texture       *d3d9.Texture
+	surface       *d3d9.Surface
+	backBuffer    *d3d9.Surface
+	width, height int
 }
 
 type shape int
@@ -464,6 +585,43 @@ func handleMessage(window w32.HWND, msg uint32, w, l uintptr) uintptr {
 	case w32.WM_MOUSEHWHEEL:
 		globalWindow.wheelX += float64(int16(w32.HIWORD(uint32(w)))) / 120.0
 		return 0
+	case w32.WM_SIZE:
+		if globalWindow != nil {
+			globalWindow.resized = true
+		}
+		return 0
+	case w32.WM_SETFOCUS:
+		if globalWindow != nil {
+			globalWindow.focused = true
+			globalWindow.gainedFocus = true
+		}
+		return 0
+	case w32.WM_KILLFOCUS:
+		if globalWindow != nil {
+			globalWindow.focused = false
+			globalWindow.lostFocus = true
+			// We do not get the key up messages for keys that are released
+			// while another window has the focus.
+			globalWindow.keyDown = [keyCount]bool{}
+			globalWindow.mouseDown = [mouseButtonCount]bool{}
+		}
+		return 0
+	case w32.WM_GETMINMAXINFO:
+		if globalWindow != nil {
+			info := *(**w32.MINMAXINFO)(unsafe.Pointer(&l))
+			globalWindow.limitSize(info)
+		}
+		return 0
+	case wmDPIChanged:
+		// The window was moved to a monitor with a different scaling. Windows
+		// suggests a new window rectangle that keeps its apparent size.
+		r := *(**w32.RECT)(unsafe.Pointer(&l))
+		w32.SetWindowPos(
+			window, 0,
+			int(r.Left), int(r.Top), int(r.Width()), int(r.Height()),
+			w32.SWP_NOZORDER|w32.SWP_NOACTIVATE,
+		)
+		return 0
 	case w32.WM_DESTROY:
 		if globalWindow != nil {
 			globalWindow.running = false
@@ -575,28 +733,412 @@ func iconFromImage(img image.Image) (w32.HICON, error) {
 }
 
 func (w *window) Size() (int, int) {
+	if w.virtual.active() {
+		return w.virtual.width, w.virtual.height
+	}
+	return w.windowSize()
+}
+
+func (w *window) windowSize() (int, int) {
 	r := w32.GetClientRect(w.handle)
 	return int(r.Right - r.Left), int(r.Bottom - r.Top)
 }
 
+func (w *window) SetFrameRate(framesPerSecond int) {
+	w.frameRate = normalizeFrameRate(framesPerSecond)
+}
+
+func (w *window) DeltaTime() float64 {
+	return w.timer.deltaTime()
+}
+
+func (w *window) FrameCount() int {
+	return w.timer.frameCount()
+}
+
+func (w *window) FPS() float64 {
+	return w.timer.fps
+}
+
+func (w *window) FrameStats() FrameStats {
+	return w.stats.stats
+}
+
+func (w *window) ContentScale() float32 {
+	if HighDPI && getDpiForWindow.Find() == nil {
+		dpi, _, _ := getDpiForWindow.Call(uintptr(w.handle))
+		if dpi != 0 {
+			return float32(dpi) / 96
+		}
+	}
+	return systemContentScale()
+}
+
+func (w *window) SetVirtualSize(width, height int, mode ScaleMode) {
+	v := virtualScreen{width: width, height: height, mode: mode}
+	if !v.active() {
+		v = virtualScreen{}
+	}
+	if v == w.virtual {
+		return
+	}
+
+	// The new size takes effect right away. What was drawn so far in this
+	// frame is scaled to the window with the old settings.
+	w.endVirtualScreen()
+	w.virtual = v
+	w.beginVirtualScreen()
+}
+
+// beginVirtualScreen redirects all drawing to the offscreen render target if
+// a virtual size is set.
+func (w *window) beginVirtualScreen() {
+	if !w.virtual.active() {
+		return
+	}
+	w.flushBacklog()
+
+	t := &w.virtualTarget
+	if t.texture == nil || t.width != w.virtual.width || t.height != w.virtual.height {
+		w.releaseRenderTarget()
+
+		texture, err := w.device.CreateTexture(
+			uint(w.virtual.width),
+			uint(w.virtual.height),
+			1,
+			d3d9.USAGE_RENDERTARGET,
+			d3d9.FMT_A8R8G8B8,
+			d3d9.POOL_DEFAULT,
+			0,
+		)
+		if err != nil {
+			w.d3d9Error = err
+			return
+		}
+		surface, err := texture.GetSurfaceLevel(0)
+		if err != nil {
+			texture.Release()
+			w.d3d9Error = err
+			return
+		}
+		t.texture, t.surface = texture, surface
+		t.width, t.height = w.virtual.width, w.virtual.height
+	}
+
+	// Device.GetRenderTarget does not return the surface, so we get the back
+	// buffer directly, it is our only other render target.
+	backBuffer, err := w.device.GetBackBuffer(0, 0, d3d9.BACKBUFFER_TYPE_MONO)
+	if err != nil {
+		w.d3d9Error = err
+		return
+	}
+	if err := w.device.SetRenderTarget(0, t.surface); err != nil {
+		backBuffer.Release()
+		w.d3d9Error = err
+		return
+	}
+	t.backBuffer = backBuffer
+
+	c := w.clearColor
+	w.device.Clear(nil, d3d9.CLEAR_TARGET, d3d9.ColorValue(c.R, c.G, c.B, c.A), 1, 0)
+}
+
+// endVirtualScreen draws the offscreen render target, scaled to the back
+// buffer.
+func (w *window) endVirtualScreen() {
+	t := &w.virtualTarget
+	if t.backBuffer == nil {
+		return
+	}
+	w.flushBacklog()
+
+	err := w.device.SetRenderTarget(0, t.backBuffer)
+	t.backBuffer.Release()
+	t.backBuffer = nil
+	if err != nil {
+		w.d3d9Error = err
+		return
+	}
+	// The bars around the scaled screen are black, not the clear color.
+	w.device.Clear(nil, d3d9.CLEAR_TARGET, d3d9.ColorValue(0, 0, 0, 1), 1, 0)
+
+	windowW, windowH := w.windowSize()
+	x, y, width, height := w.virtual.viewport(windowW, windowH)
+	x1, y1 := float32(x)-0.5, float32(y)-0.5
+	x2, y2 := float32(x+width)-0.5, float32(y+height)-0.5
+	col := colorToFloat32(White)
+	data := [...]float32{
+		x1, y1, 0, 1, col, 0, 0,
+		x2, y1, 0, 1, col, 1, 0,
+		x1, y2, 0, 1, col, 0, 1,
+		x2, y2, 0, 1, col, 1, 1,
+	}
+
+	w.updateTextureFilter(w.virtual.mode != ScalePixelPerfect)
+	// The render target is opaque, blending it would darken the colors where
+	// its alpha is less than 1.
+	w.device.SetRenderState(d3d9.RS_ALPHABLENDENABLE, 0)
+	defer w.device.SetRenderState(d3d9.RS_ALPHABLENDENABLE, 1)
+
+	if err := w.device.SetTexture(0, t.texture); err != nil {
+		w.d3d9Error = err
+		return
+	}
+	if err := w.device.DrawPrimitiveUP(
+		d3d9.PT_TRIANGLESTRIP,
+		2,
+		uintptr(unsafe.Pointer(&data[0])),
+		vertexStride,
+	); err != nil {
+		w.d3d9Error = err
+	}
+	if err := w.device.SetTexture(0, nil); err != nil {
+		w.d3d9Error = err
+	}
+}
+
+// releaseRenderTarget frees the offscreen render target. It lives in the
+// default pool and must be released before the device can be reset.
+func (w *window) releaseRenderTarget() {
+	t := &w.virtualTarget
+	if t.backBuffer != nil {
+		t.backBuffer.Release()
+	}
+	if t.surface != nil {
+		t.surface.Release()
+	}
+	if t.texture != nil {
+		t.texture.Release()
+	}
+	*t = renderTarget{}
+}
+
 func (w *window) SetFullscreen(f bool) {
 	if f == w.isFullscreen {
 		return
 	}
 
 	if f {
-		w.windowed = enableFullscreen(w.handle)
+		monitor := w32.MonitorFromWindow(w.handle, w32.MONITOR_DEFAULTTOPRIMARY)
+		w.enterFullscreen(monitor)
 	} else {
-		disableFullscreen(w.handle, w.windowed)
+		w.isFullscreen = false
+		w.restoreDisplayMode()
+		disableFullscreen(w.handle, w.windowed, w.style())
+		w.updateRefreshRate()
+	}
+}
+
+func (w *window) SetFullscreenMode(monitor int, mode VideoMode) {
+	monitors := displayMonitors()
+	if len(monitors) == 0 {
+		return
+	}
+	if monitor < 0 || monitor >= len(monitors) {
+		monitor = 0
+		for i, m := range monitors {
+			if info, ok := monitorInfo(m); ok &&
+				info.DwFlags&w32.MONITORINFOF_PRIMARY != 0 {
+				monitor = i
+			}
+		}
+	}
+
+	// Go back to the window first, the window placement is stored when going
+	// fullscreen and the monitor might still be in another video mode.
+	w.SetFullscreen(false)
+
+	if mode != (VideoMode{}) {
+		if info, ok := monitorInfo(monitors[monitor]); ok &&
+			changeDisplayMode(&info.SzDevice[0], mode) {
+			w.displayModeDevice = info.SzDevice
+		}
+	}
+	w.enterFullscreen(monitors[monitor])
+}
+
+// enterFullscreen makes the window cover the monitor.
+func (w *window) enterFullscreen(monitor w32.HMONITOR) {
+	// Set the flag first so the size limits are ignored in fullscreen.
+	w.isFullscreen = true
+	w.windowed = enableFullscreen(w.handle, monitor)
+	w.updateRefreshRate()
+}
+
+// restoreDisplayMode resets the monitor's video mode if we changed it for
+// exclusive fullscreen.
+func (w *window) restoreDisplayMode() {
+	if w.displayModeDevice[0] != 0 {
+		w32.ChangeDisplaySettingsEx(&w.displayModeDevice[0], nil, 0, 0, 0)
+		w.displayModeDevice = [w32.CCHDEVICENAME]uint16{}
 	}
+}
 
-	w.isFullscreen = f
+// updateRefreshRate sets the refresh rate to that of the monitor that the
+// window is on, it might have changed by moving to a different monitor or
+// changing the video mode.
+func (w *window) updateRefreshRate() {
+	monitor := w32.MonitorFromWindow(w.handle, w32.MONITOR_DEFAULTTOPRIMARY)
+	if info, ok := monitorInfo(monitor); ok {
+		// Refresh rates of 0 and 1 mean that the hardware default is used.
+		if mode, ok := currentVideoMode(&info.SzDevice[0]); ok && mode.RefreshRate > 1 {
+			w.refreshRate = mode.RefreshRate
+		}
+	}
 }
 
 func (w *window) IsFullscreen() bool {
 	return w.isFullscreen
 }
 
+// style returns the window style for windowed mode.
+func (w *window) style() uint {
+	if w.borderless {
+		return w32.WS_POPUP | w32.WS_VISIBLE
+	}
+	if w.resizable {
+		return windowedStyle | w32.WS_THICKFRAME | w32.WS_MAXIMIZEBOX
+	}
+	return windowedStyle
+}
+
+func (w *window) SetResizable(resizable bool) {
+	if resizable == w.resizable {
+		return
+	}
+	w.resizable = resizable
+
+	if w.isFullscreen {
+		// The style is set when leaving fullscreen.
+		return
+	}
+
+	// Changing the border changes the window size, so we adjust the window to
+	// keep the drawing area the same size.
+	clientW, clientH := w.windowSize()
+	r := w32.RECT{Right: int32(clientW), Bottom: int32(clientH)}
+	w32.AdjustWindowRect(&r, w.style(), false)
+	w32.SetWindowLong(w.handle, w32.GWL_STYLE, int32(w.style()))
+	w32.SetWindowPos(w.handle, 0, 0, 0, int(r.Width()), int(r.Height()),
+		w32.SWP_NOMOVE|w32.SWP_NOZORDER|w32.SWP_NOOWNERZORDER|w32.SWP_FRAMECHANGED,
+	)
+}
+
+func (w *window) WasResized() bool {
+	return w.resized
+}
+
+func (w *window) SetSizeLimits(minWidth, minHeight, maxWidth, maxHeight int) {
+	w.minWidth, w.minHeight = minWidth, minHeight
+	w.maxWidth, w.maxHeight = maxWidth, maxHeight
+}
+
+func (w *window) SetTitle(title string) {
+	w32.SetWindowText(w.handle, title)
+}
+
+func (w *window) SetPosition(x, y int) {
+	if w.isFullscreen {
+		return
+	}
+	// The position is given for the drawing area, the window border is to the
+	// top-left of it.
+	r := w32.GetWindowRect(w.handle)
+	clientX, clientY := w32.ClientToScreen(w.handle, 0, 0)
+	w32.SetWindowPos(
+		w.handle, 0,
+		x-(clientX-int(r.Left)), y-(clientY-int(r.Top)), 0, 0,
+		w32.SWP_NOSIZE|w32.SWP_NOZORDER|w32.SWP_NOACTIVATE,
+	)
+}
+
+func (w *window) Position() (x, y int) {
+	return w32.ClientToScreen(w.handle, 0, 0)
+}
+
+func (w *window) Minimize() {
+	w32.ShowWindow(w.handle, w32.SW_MINIMIZE)
+}
+
+func (w *window) Restore() {
+	w32.ShowWindow(w.handle, w32.SW_RESTORE)
+}
+
+func (w *window) RequestAttention() {
+	// Flash the task bar button until the window comes to the foreground.
+	const (
+		flashTray         = 2
+		flashUntilFocused = 12
+	)
+	info := flashWindowInfo{
+		hwnd:  w.handle,
+		flags: flashTray | flashUntilFocused,
+	}
+	info.size = uint32(unsafe.Sizeof(info))
+	flashWindowEx.Call(uintptr(unsafe.Pointer(&info)))
+}
+
+// flashWindowInfo is the FLASHWINFO struct for FlashWindowEx.
+type flashWindowInfo struct {
+	size    uint32
+	hwnd    w32.HWND
+	flags   uint32
+	count   uint32
+	timeout uint32
+}
+
+func (w *window) HasFocus() bool {
+	return w.focused
+}
+
+func (w *window) GainedFocus() bool {
+	return w.gainedFocus
+}
+
+func (w *window) LostFocus() bool {
+	return w.lostFocus
+}
+
+// limitSize handles WM_GETMINMAXINFO. The size limits are given for the
+// client area, Windows wants them for the whole window, including the border.
+func (w *window) limitSize(info *w32.MINMAXINFO) {
+	if w.isFullscreen {
+		return
+	}
+
+	toWindowSize := func(width, height int) w32.POINT {
+		r := w32.RECT{Right: int32(width), Bottom: int32(height)}
+		w32.AdjustWindowRect(&r, w.style(), false)
+		return w32.POINT{X: r.Width(), Y: r.Height()}
+	}
+
+	minSize := toWindowSize(w.minWidth, w.minHeight)
+	if w.minWidth > 0 {
+		info.PtMinTrackSize.X = minSize.X
+	}
+	if w.minHeight > 0 {
+		info.PtMinTrackSize.Y = minSize.Y
+	}
+
+	maxWidth, maxHeight := w.maxWidth, w.maxHeight
+	if maxWidth <= 0 || maxWidth > w.backBufferWidth {
+		maxWidth = w.backBufferWidth
+	}
+	if maxHeight <= 0 || maxHeight > w.backBufferHeight {
+		maxHeight = w.backBufferHeight
+	}
+	if maxWidth > 0 && maxHeight > 0 {
+		maxSize := toWindowSize(maxWidth, maxHeight)
+		info.PtMaxTrackSize = maxSize
+		if info.PtMaxSize.X > maxSize.X {
+			info.PtMaxSize.X = maxSize.X
+		}
+		if info.PtMaxSize.Y > maxSize.Y {
+			info.PtMaxSize.Y = maxSize.Y
+		}
+	}
+}
+
 func (w *window) ShowCursor(show bool) {
 	if show == w.showingCursor {
 		return
@@ -622,13 +1164,12 @@ func setShowCursorCountTo(count int) {
 }
 
 // enableFullscreen makes the window a borderless window that covers the full
-// area of the monitor under the window.
+// area of the given monitor.
 // It returns the previous window placement. Store that value and use it with
 // disableFullscreen to reset the window to what it was before.
-func enableFullscreen(window w32.HWND) (windowed w32.WINDOWPLACEMENT) {
+func enableFullscreen(window w32.HWND, monitor w32.HMONITOR) (windowed w32.WINDOWPLACEMENT) {
 	style := w32.GetWindowLong(window, w32.GWL_STYLE)
 	var monitorInfo w32.MONITORINFO
-	monitor := w32.MonitorFromWindow(window, w32.MONITOR_DEFAULTTOPRIMARY)
 	if w32.GetWindowPlacement(window, &windowed) &&
 		w32.GetMonitorInfo(monitor, &monitorInfo) {
 		w32.SetWindowLong(
@@ -653,8 +1194,8 @@ func enableFullscreen(window w32.HWND) (windowed w32.WINDOWPLACEMENT) {
 // and places it at the position given by the window placement parameter.
 // Use this in conjunction with enableFullscreen to toggle a window's fullscreen
 // state.
-func disableFullscreen(window w32.HWND, placement w32.WINDOWPLACEMENT) {
-	w32.SetWindowLong(window, w32.GWL_STYLE, windowedStyle)
+func disableFullscreen(window w32.HWND, placement w32.WINDOWPLACEMENT, style uint) {
+	w32.SetWindowLong(window, w32.GWL_STYLE, int32(style))
 	w32.SetWindowPlacement(window, &placement)
 	w32.SetWindowPos(window, 0, 0, 0, 0, 0,
 		w32.SWP_NOMOVE|w32.SWP_NOSIZE|w32.SWP_NOZORDER|
@@ -662,6 +1203,134 @@ func disableFullscreen(window w32.HWND, placement w32.WINDOWPLACEMENT) {
 	)
 }
 
+func (w *window) Monitors() []Monitor {
+	var monitors []Monitor
+	for _, handle := range displayMonitors() {
+		info, ok := monitorInfo(handle)
+		if !ok {
+			continue
+		}
+		device := &info.SzDevice[0]
+		current, _ := currentVideoMode(device)
+		monitors = append(monitors, Monitor{
+			Name:        monitorName(info.SzDevice),
+			X:           int(info.RcMonitor.Left),
+			Y:           int(info.RcMonitor.Top),
+			Width:       int(info.RcMonitor.Width()),
+			Height:      int(info.RcMonitor.Height()),
+			WorkX:       int(info.RcWork.Left),
+			WorkY:       int(info.RcWork.Top),
+			WorkWidth:   int(info.RcWork.Width()),
+			WorkHeight:  int(info.RcWork.Height()),
+			Primary:     info.DwFlags&w32.MONITORINFOF_PRIMARY != 0,
+			CurrentMode: current,
+			Modes:       videoModes(device),
+		})
+	}
+	return monitors
+}
+
+var (
+	// enumMonitorsCallback is created only once, Windows limits the number
+	// of callbacks that a program can create.
+	enumMonitorsCallback = syscall.NewCallback(func(monitor, _, _, _ uintptr) uintptr {
+		enumeratedMonitors = append(enumeratedMonitors, w32.HMONITOR(monitor))
+		return 1 // continue enumeration
+	})
+	enumeratedMonitors []w32.HMONITOR
+)
+
+// displayMonitors returns all monitors in the order that Windows enumerates
+// them.
+func displayMonitors() []w32.HMONITOR {
+	enumeratedMonitors = nil
+	w32.EnumDisplayMonitors(0, nil, enumMonitorsCallback, 0)
+	return enumeratedMonitors
+}
+
+// monitorInfo is like w32.GetMonitorInfo but also returns the monitor's
+// device name which we need to query and change its video modes.
+func monitorInfo(monitor w32.HMONITOR) (info w32.MONITORINFOEX, ok bool) {
+	info.CbSize = uint32(unsafe.Sizeof(info))
+	ret, _, _ := getMonitorInfo.Call(uintptr(monitor), uintptr(unsafe.Pointer(&info)))
+	return info, ret != 0
+}
+
+// monitorName returns the display name of the monitor with the given device
+// name, e.g. "Generic PnP Monitor", or the device name itself if the monitor
+// has no display name.
+func monitorName(device [w32.CCHDEVICENAME]uint16) string {
+	var d displayDevice
+	d.size = uint32(unsafe.Sizeof(d))
+	ret, _, _ := enumDisplayDevices.Call(
+		uintptr(unsafe.Pointer(&device[0])),
+		0,
+		uintptr(unsafe.Pointer(&d)),
+		0,
+	)
+	if ret != 0 && d.deviceString[0] != 0 {
+		return syscall.UTF16ToString(d.deviceString[:])
+	}
+	return syscall.UTF16ToString(device[:])
+}
+
+// displayDevice is the DISPLAY_DEVICEW struct for EnumDisplayDevicesW.
+type displayDevice struct {
+	size         uint32
+	deviceName   [32]uint16
+	deviceString [128]uint16
+	stateFlags   uint32
+	deviceID     [128]uint16
+	deviceKey    [128]uint16
+}
+
+func currentVideoMode(device *uint16) (VideoMode, bool) {
+	var mode w32.DEVMODE
+	mode.DmSize = uint16(unsafe.Sizeof(mode))
+	if !w32.EnumDisplaySettingsEx(device, w32.ENUM_CURRENT_SETTINGS, &mode, 0) {
+		return VideoMode{}, false
+	}
+	return toVideoMode(mode), true
+}
+
+// videoModes lists the 32 bit color modes of the monitor device, we do not
+// support other color depths.
+func videoModes(device *uint16) []VideoMode {
+	var modes []VideoMode
+	var mode w32.DEVMODE
+	mode.DmSize = uint16(unsafe.Sizeof(mode))
+	for i := uint32(0); w32.EnumDisplaySettingsEx(device, i, &mode, 0); i++ {
+		if mode.DmBitsPerPel == 32 {
+			modes = append(modes, toVideoMode(mode))
+		}
+	}
+	return sortVideoModes(modes)
+}
+
+func toVideoMode(mode w32.DEVMODE) VideoMode {
+	return VideoMode{
+		Width:       int(mode.DmPelsWidth),
+		Height:      int(mode.DmPelsHeight),
+		RefreshRate: int(mode.DmDisplayFrequency),
+	}
+}
+
+// changeDisplayMode switches the monitor device to the video mode until it is
+// reset or our program exits.
+func changeDisplayMode(device *uint16, mode VideoMode) bool {
+	var m w32.DEVMODE
+	m.DmSize = uint16(unsafe.Sizeof(m))
+	m.DmFields = w32.DM_PELSWIDTH | w32.DM_PELSHEIGHT
+	m.DmPelsWidth = uint32(mode.Width)
+	m.DmPelsHeight = uint32(mode.Height)
+	if mode.RefreshRate > 0 {
+		m.DmFields |= w32.DM_DISPLAYFREQUENCY
+		m.DmDisplayFrequency = uint32(mode.RefreshRate)
+	}
+	return w32.ChangeDisplaySettingsEx(device, &m, 0, w32.CDS_FULLSCREEN, 0) ==
+		w32.DISP_CHANGE_SUCCESSFUL
+}
+
 func (w *window) WasKeyPressed(key Key) bool {
 	for _, pressed := range w.pressed {
 		if pressed == key {
@@ -682,6 +1351,61 @@ func (w *window) Characters() string {
 	return w.text
 }
 
+func (w *window) ClipboardText() string {
+	if !w32.OpenClipboard(w.handle) {
+		return ""
+	}
+	defer w32.CloseClipboard()
+
+	data := w32.HGLOBAL(w32.GetClipboardData(w32.CF_UNICODETEXT))
+	if data == 0 {
+		return ""
+	}
+	p := w32.GlobalLock(data)
+	if p == nil {
+		return ""
+	}
+	defer w32.GlobalUnlock(data)
+
+	var text []uint16
+	for i := uintptr(0); ; i += 2 {
+		c := *(*uint16)(unsafe.Pointer(uintptr(p) + i))
+		if c == 0 {
+			break
+		}
+		text = append(text, c)
+	}
+	return strings.Replace(string(utf16.Decode(text)), "\r\n", "\n", -1)
+}
+
+func (w *window) SetClipboardText(text string) {
+	text = strings.Replace(text, "\r\n", "\n", -1)
+	text = strings.Replace(text, "\n", "\r\n", -1)
+	utf := append(utf16.Encode([]rune(text)), 0)
+
+	if !w32.OpenClipboard(w.handle) {
+		return
+	}
+	defer w32.CloseClipboard()
+	w32.EmptyClipboard()
+
+	data := w32.GlobalAlloc(w32.GMEM_MOVEABLE, uint32(2*len(utf)))
+	if data == 0 {
+		return
+	}
+	p := w32.GlobalLock(data)
+	if p == nil {
+		w32.GlobalFree(data)
+		return
+	}
+	copy((*[1 << 30]uint16)(p)[:len(utf):len(utf)], utf)
+	w32.GlobalUnlock(data)
+	// The clipboard owns the memory once SetClipboardData succeeds.
+	if w32.SetClipboardData(w32.CF_UNICODETEXT, w32.HANDLE(data)) == 0 {
+		w32.GlobalFree(data)
+	}
+}
+
 func (w *window) IsMouseDown(button MouseButton) bool {
 	if button < 0 || button >= mouseButtonCount {
 		return false
@@ -716,7 +1440,8 @@ func (w *window) updateMouseInfo() {
 }
 
 func (w *window) MousePosition() (int, int) {
-	return w.mouse.x, w.mouse.y
+	windowW, windowH := w.windowSize()
+	return w.virtual.toVirtual(w.mouse.x, w.mouse.y, windowW, windowH)
 }
 
 func (w *window) MouseWheelX() float64 {
@@ -741,6 +1466,24 @@ func (w *window) addBacklog(typ shape, data ...float32) {
 	w.backlogType = typ
 }
 
+// addTextBacklog is like addBacklog for texts but also tracks the texture that
+// they use. All texts in the backlog are drawn with the same texture.
+func (w *window) addTextBacklog(texture string, data ...float32) {
+	if w.backlogType == texts && texture != w.textTexture {
+		w.flushBacklog()
+	}
+	if t := w.textTransform; t != nil {
+		// Each vertex is x, y, z, rhw, color, u, v. The positions are offset
+		// by half a pixel, which we keep after the transform.
+		for i := 0; i+1 < len(data); i += 7 {
+			x, y := t.apply(data[i]+0.5, data[i+1]+0.5)
+			data[i], data[i+1] = x-0.5, y-0.5
+		}
+	}
+	w.textTexture = texture
+	w.addBacklog(texts, data...)
+}
+
 func (w *window) flushBacklog() {
 	if len(w.backlog) == 0 {
 		return
@@ -756,7 +1499,7 @@ func (w *window) flushBacklog() {
 	case texts:
 		w.updateTextureFilter(true)
 
-		if err := w.device.SetTexture(0, w.textures[fontTextureID].texture); err != nil {
+		if err := w.device.SetTexture(0, w.textures[w.textTexture].texture); err != nil {
 			w.d3d9Error = err
 		}
 
@@ -874,18 +1617,22 @@ func (w *window) ImageSize(path string) (width, height int, err error) {
 }
 
 func (w *window) DrawImageFile(path string, x, y int) error {
-	return w.renderImage(path, x, y, 0, 0, 0, 0, 0, 0, 0)
+	return w.renderImage(path, x, y, 0, 0, 0, 0, 0, 0, 0, White)
 }
 
 func (w *window) DrawImageFileRotated(path string, x, y, degrees int) error {
-	return w.renderImage(path, x, y, 0, 0, 0, 0, 0, 0, degrees)
+	return w.renderImage(path, x, y, 0, 0, 0, 0, 0, 0, degrees, White)
 }
 
 func (w *window) DrawImageFileTo(path string, x, y, width, height, degrees int) error {
+	return w.DrawImageFileTinted(path, x, y, width, height, degrees, White)
+}
+
+func (w *window) DrawImageFileTinted(path string, x, y, width, height, degrees int, tint Color) error {
 	if width == 0 || height == 0 {
 		return nil
 	}
-	return w.renderImage(path, x, y, width, height, 0, 0, 0, 0, degrees)
+	return w.renderImage(path, x, y, width, height, 0, 0, 0, 0, degrees, tint)
 }
 
 func (w *window) DrawImageFilePart(
@@ -901,34 +1648,297 @@ func (w *window) DrawImageFilePart(
 		path,
 		destX, destY, destWidth, destHeight,
 		sourceX, sourceY, sourceWidth, sourceHeight,
-		rotationCWDeg,
+		rotationCWDeg, White,
+	)
+}
+
+func (w *window) PixelAt(x, y int) Color {
+	width, height := w.Size()
+	if x < 0 || y < 0 || x >= width || y >= height {
+		return Color{}
+	}
+
+	w.flushBacklog()
+
+	source := w.virtualTarget.surface
+	if w.virtualTarget.backBuffer == nil {
+		backBuffer, err := w.device.GetBackBuffer(0, 0, d3d9.BACKBUFFER_TYPE_MONO)
+		if err != nil {
+			w.d3d9Error = err
+			return Color{}
+		}
+		defer backBuffer.Release()
+		source = backBuffer
+	}
+
+	// Render targets cannot be locked. We copy the pixel to a small render
+	// target, converting it to a known format, and read that back to system
+	// memory.
+	target, err := w.device.CreateRenderTarget(
+		1, 1, d3d9.FMT_A8R8G8B8, d3d9.MULTISAMPLE_NONE, 0, false, 0,
 	)
+	if err != nil {
+		w.d3d9Error = err
+		return Color{}
+	}
+	defer target.Release()
+
+	memory, err := w.device.CreateOffscreenPlainSurface(
+		1, 1, d3d9.FMT_A8R8G8B8, d3d9.POOL_SYSTEMMEM, 0,
+	)
+	if err != nil {
+		w.d3d9Error = err
+		return Color{}
+	}
+	defer memory.Release()
+
+	r := d3d9.RECT{Left: int32(x), Top: int32(y), Right: int32(x + 1), Bottom: int32(y + 1)}
+	if err := w.device.StretchRect(source, &r, target, nil, d3d9.TEXF_NONE); err != nil {
+		w.d3d9Error = err
+		return Color{}
+	}
+	if err := w.device.GetRenderTargetData(target, memory); err != nil {
+		w.d3d9Error = err
+		return Color{}
+	}
+
+	locked, err := memory.LockRect(nil, d3d9.LOCK_READONLY)
+	if err != nil {
+		w.d3d9Error = err
+		return Color{}
+	}
+	bgra := **(**[4]byte)(unsafe.Pointer(&locked.PBits))
+	memory.UnlockRect()
+
+	return RGB(
+		float32(bgra[2])/255,
+		float32(bgra[1])/255,
+		float32(bgra[0])/255,
+	)
+}
+
+// pixelTexture is the streaming texture for DrawPixels. It only ever grows, the
+// images are written to its top-left corner. Its buffer holds the pixels in
+// the texture's BGRA format.
+type pixelTexture struct {
+	texture       *d3d9.Texture
+	width, height int
+	buffer        []byte
+}
+
+func (w *window) DrawPixels(x, y int, img *image.RGBA) {
+	w.DrawPixelsTo(x, y, img.Rect.Dx(), img.Rect.Dy(), img)
+}
+
+func (w *window) DrawPixelsTo(x, y, width, height int, img *image.RGBA) {
+	imgW, imgH := img.Rect.Dx(), img.Rect.Dy()
+	if imgW <= 0 || imgH <= 0 || width == 0 || height == 0 {
+		return
+	}
+
+	w.flushBacklog()
+
+	if err := w.updatePixelTexture(img); err != nil {
+		w.d3d9Error = err
+		return
+	}
+
+	col := colorToFloat32(White)
+	x1, y1 := float32(x)-0.5, float32(y)-0.5
+	x2, y2 := float32(x+width)-0.5, float32(y+height)-0.5
+	u := float32(imgW) / float32(w.pixels.width)
+	v := float32(imgH) / float32(w.pixels.height)
+	data := [...]float32{
+		x1, y1, 0, 1, col, 0, 0,
+		x2, y1, 0, 1, col, u, 0,
+		x1, y2, 0, 1, col, 0, v,
+
+		x1, y2, 0, 1, col, 0, v,
+		x2, y1, 0, 1, col, u, 0,
+		x2, y2, 0, 1, col, u, v,
+	}
+
+	w.updateTextureFilter(w.blurImages)
+
+	if err := w.device.SetTexture(0, w.pixels.texture); err != nil {
+		w.d3d9Error = err
+		return
+	}
+	// The image colors are premultiplied with alpha.
+	w.device.SetRenderState(d3d9.RS_SRCBLEND, d3d9.BLEND_ONE)
+
+	if err := w.device.DrawPrimitiveUP(
+		d3d9.PT_TRIANGLELIST,
+		2,
+		uintptr(unsafe.Pointer(&data[0])),
+		vertexStride,
+	); err != nil {
+		w.d3d9Error = err
+	}
+
+	w.device.SetRenderState(d3d9.RS_SRCBLEND, d3d9.BLEND_SRCALPHA)
+	if err := w.device.SetTexture(0, nil); err != nil {
+		w.d3d9Error = err
+	}
+}
+
+// updatePixelTexture writes the image to the top-left of the pixel texture,
+// growing it if necessary. The texture is one pixel larger than the image so
+// the image's last column and row can be repeated next to it, see pixelRows.
+func (w *window) updatePixelTexture(img *image.RGBA) d3d9.Error {
+	imgW, imgH := img.Rect.Dx(), img.Rect.Dy()
+	t := &w.pixels
+
+	if t.texture == nil || imgW+1 > t.width || imgH+1 > t.height {
+		newW, newH := maxInt(imgW+1, t.width), maxInt(imgH+1, t.height)
+		w.releasePixelTexture()
+		texture, err := w.device.CreateTexture(
+			uint(newW),
+			uint(newH),
+			1,
+			d3d9.USAGE_DYNAMIC,
+			d3d9.FMT_A8R8G8B8,
+			d3d9.POOL_DEFAULT,
+			0,
+		)
+		if err != nil {
+			return err
+		}
+		t.texture, t.width, t.height = texture, newW, newH
+	}
+
+	buf, width, _ := pixelRows(img, t.buffer, true)
+	t.buffer = buf
+	for i := 0; i < len(buf); i += 4 {
+		buf[i+0], buf[i+2] = buf[i+2], buf[i+0]
+	}
+
+	rect, err := t.texture.LockRect(0, nil, d3d9.LOCK_DISCARD)
+	if err != nil {
+		return err
+	}
+	rect.SetAllBytes(buf, 4*width)
+	return t.texture.UnlockRect(0)
+}
+
+// releasePixelTexture frees the pixel texture. It lives in the default pool
+// so it must be released before resetting the device.
+func (w *window) releasePixelTexture() {
+	if w.pixels.texture != nil {
+		w.pixels.texture.Release()
+	}
+	w.pixels.texture = nil
+	w.pixels.width, w.pixels.height = 0, 0
 }
 
 func (w *window) BlurImages(blur bool) {
 	w.blurImages = blur
 }
 
+func (w *window) DrawNinePatch(
+	path string,
+	insetLeft, insetTop, insetRight, insetBottom int,
+	x, y, width, height int,
+) error {
+	w.flushBacklog()
+
+	if _, ok := w.textures[path]; !ok {
+		if err := w.loadTexture(path); err != nil {
+			return err
+		}
+	}
+
+	texture, ok := w.textures[path]
+	if !ok {
+		return errors.New("texture not found after loading: " + path)
+	}
+
+	insets := ninePatchInsets{insetLeft, insetTop, insetRight, insetBottom}
+	if isNinePatchFile(path) {
+		insets = texture.ninePatch
+	}
+	parts := ninePatchParts(
+		texture.width, texture.height,
+		insets,
+		x, y, width, height,
+		w.tileNinePatch,
+	)
+	if len(parts) == 0 {
+		return nil
+	}
+
+	col := colorToFloat32(White)
+	texW, texH := float32(texture.width), float32(texture.height)
+	data := make([]float32, 0, len(parts)*6*vertexStride/4)
+	for _, p := range parts {
+		x1, y1 := float32(p.destX)-0.5, float32(p.destY)-0.5
+		x2, y2 := float32(p.destX+p.destW)-0.5, float32(p.destY+p.destH)-0.5
+		srcX, srcY, srcW, srcH := p.texRect(w.blurImages)
+		u1, v1 := srcX/texW, srcY/texH
+		u2, v2 := (srcX+srcW)/texW, (srcY+srcH)/texH
+		data = append(data,
+			x1, y1, 0, 1, col, u1, v1,
+			x2, y1, 0, 1, col, u2, v1,
+			x1, y2, 0, 1, col, u1, v2,
+
+			x1, y2, 0, 1, col, u1, v2,
+			x2, y1, 0, 1, col, u2, v1,
+			x2, y2, 0, 1, col, u2, v2,
+		)
+	}
+
+	w.updateTextureFilter(w.blurImages)
+
+	if err := w.device.SetTexture(0, texture.texture); err != nil {
+		return err
+	}
+
+	if err := w.device.DrawPrimitiveUP(
+		d3d9.PT_TRIANGLELIST,
+		uint(len(parts)*2),
+		uintptr(unsafe.Pointer(&data[0])),
+		vertexStride,
+	); err != nil {
+		w.d3d9Error = err
+	}
+
+	// reset the texture
+	if err := w.device.SetTexture(0, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (w *window) TileNinePatches(tile bool) {
+	w.tileNinePatch = tile
+}
+
+func (w *window) SetFont(font *Font) {
+	w.font = font
+}
+
+func (w *window) SetTextOutline(width int, color Color) {
+	w.textEffects.outlineWidth = width
+	w.textEffects.outlineColor = color
+}
+
+func (w *window) SetTextShadow(dx, dy int, color Color) {
+	w.textEffects.shadowX = dx
+	w.textEffects.shadowY = dy
+	w.textEffects.shadowColor = color
+}
+
 func (win *window) GetTextSize(text string) (w, h int) {
 	return win.GetScaledTextSize(text, 1)
 }
 
 func (w *window) GetScaledTextSize(text string, scale float32) (width, height int) {
-	scale *= fontBaseScale
-	lines := strings.Split(text, "\n")
-	maxLineW := 0
-	for _, line := range lines {
-		w := utf8.RuneCountInString(line)
-		if w > maxLineW {
-			maxLineW = w
-		}
+	if w.font != nil {
+		return w.font.textSize(text, scale)
 	}
 
-	charW := fontCharW - 2*fontGlyphMargin
-	charH := fontCharH - 2*fontGlyphMargin
-	width = int(float32(charW*maxLineW)*scale*fontKerningFactor + 0.5)
-	height = int(float32(charH*len(lines))*scale + 0.5)
-	return width, height
+	return builtinTextSize(text, scale)
 }
 
 func (w *window) DrawText(text string, x, y int, color Color) {
@@ -939,8 +1949,35 @@ func (w *window) DrawScaledText(text string, x, y int, scale float32, color Colo
 	if len(text) == 0 || scale <= 0 {
 		return
 	}
+	w.textEffects.draw(text, x, y, scale, color, w.drawPlainText, w.drawTextOutline)
+}
+
+func (w *window) DrawTransformedText(
+	text string,
+	x, y int,
+	anchorX, anchorY float32,
+	scaleX, scaleY float32,
+	rotationCWDeg int,
+	color Color,
+) {
+	if len(text) == 0 || scaleX == 0 || scaleY == 0 {
+		return
+	}
+	width, height := w.GetTextSize(text)
+	t := newTextTransform(x, y, width, height, anchorX, anchorY, scaleX, scaleY, rotationCWDeg)
 
-	scale *= fontBaseScale
+	// The text's vertices are transformed as they are added to the backlog.
+	w.textTransform = &t
+	w.DrawText(text, 0, 0, color)
+	w.textTransform = nil
+}
+
+// drawPlainText draws the text without outline and shadow.
+func (w *window) drawPlainText(text string, x, y int, scale float32, color Color) {
+	if w.font != nil {
+		w.drawFontText(w.font, text, x, y, scale, color)
+		return
+	}
 
 	fontTextureW := 16 * fontCharW
 	fontTextureH := 16 * fontCharH
@@ -951,23 +1988,15 @@ func (w *window) DrawScaledText(text string, x, y int, scale float32, color Colo
 	uSize := float32(fontCharW-2*fontGlyphMargin) / float32(fontTextureW)
 	vSize := float32(fontCharH-2*fontGlyphMargin) / float32(fontTextureH)
 
-	width := float32(fontCharW-2*fontGlyphMargin) * scale * fontKerningFactor
-	height := float32(fontCharH-2*fontGlyphMargin) * scale
+	width, height := builtinCellSize(scale)
 	col := colorToFloat32(color)
-	destX, destY := float32(x), float32(y)
 
-	for _, r := range text {
-		if r == '\n' {
-			destX = float32(x)
-			destY += height
-			continue
-		}
-
-		index := runeToFont(r)
+	fallback := w.builtinFallback()
+	layoutBuiltinText(fallback, text, float32(x), float32(y), scale, func(index rune, destX, destY float32) {
 		u := uOffset + float32(index%16)*uStep
 		v := vOffset + float32(index/16)*vStep
 
-		w.addBacklog(texts,
+		w.addTextBacklog(fontTextureID,
 			float32(destX)-0.5, float32(destY)-0.5, 0, 1, col, u, v,
 			float32(destX)+width-0.5, float32(destY)-0.5, 0, 1, col, u+uSize, v,
 			float32(destX)-0.5, float32(destY)+height-0.5, 0, 1, col, u, v+vSize,
@@ -976,11 +2005,157 @@ func (w *window) DrawScaledText(text string, x, y int, scale float32, color Colo
 			float32(destX)+width-0.5, float32(destY)-0.5, 0, 1, col, u+uSize, v,
 			float32(destX)+width-0.5, float32(destY)+height-0.5, 0, 1, col, u+uSize, v+vSize,
 		)
+	}, nil)
 
-		destX += width
+	// Characters that are not in the font bitmap are drawn in a second pass
+	// with the fallback font's atlas.
+	if fallback != nil && fallback.version > 0 {
+		if !w.updateFontTexture(&fallback.glyphAtlas) {
+			return
+		}
+		layoutBuiltinText(fallback, text, float32(x), float32(y), scale, nil, func(g glyph, x, y, width, height float32) {
+			w.addFontGlyph(&fallback.glyphAtlas, g, x, y, width, height, col)
+		})
+	}
+}
+
+// drawTextOutline draws the outline of the text, which is outlineWidth pixels
+// wide at scale 1.
+func (w *window) drawTextOutline(text string, x, y int, scale float32, outlineWidth int, color Color) {
+	if w.font != nil {
+		outlines, quads := w.font.outlineQuads(text, float32(x), float32(y), scale, outlineWidth)
+		w.drawGlyphQuads(&outlines.glyphAtlas, quads, color)
+		return
+	}
+
+	bitmapOutlines, bitmapQuads, fallbackOutlines, fallbackQuads := builtinOutlineQuads(
+		w.builtinFallback(), text, float32(x), float32(y), scale, outlineWidth,
+	)
+	w.drawGlyphQuads(&bitmapOutlines.glyphAtlas, bitmapQuads, color)
+	if fallbackOutlines != nil {
+		w.drawGlyphQuads(&fallbackOutlines.glyphAtlas, fallbackQuads, color)
 	}
 }
 
+// drawGlyphQuads draws the glyphs from the atlas in a solid color.
+func (w *window) drawGlyphQuads(atlas *glyphAtlas, quads []glyphQuad, color Color) {
+	if len(quads) == 0 || !w.updateFontTexture(atlas) {
+		return
+	}
+	col := colorToFloat32(color)
+	for _, q := range quads {
+		w.addFontGlyph(atlas, q.g, q.x, q.y, q.width, q.height, col)
+	}
+}
+
+// builtinFallback returns the font for characters that are not in the font
+// bitmap, creating it on first use.
+func (w *window) builtinFallback() *Font {
+	if w.fallbackFont == nil {
+		w.fallbackFont = newBuiltinFallback()
+	}
+	return w.fallbackFont
+}
+
+func (w *window) AddFallbackFont(path string) error {
+	data, err := ReadFile(path)
+	if err != nil {
+		return err
+	}
+	fallback := w.builtinFallback()
+	if fallback == nil {
+		return errors.New("the built-in fallback font could not be created")
+	}
+	return fallback.ParseFallback(data)
+}
+
+func (w *window) drawFontText(font *Font, text string, x, y int, scale float32, color Color) {
+	// Rasterize all glyphs before uploading the atlas.
+	font.textSize(text, 1)
+	if !w.updateFontTexture(&font.glyphAtlas) {
+		return
+	}
+
+	col := colorToFloat32(color)
+	font.layout(text, float32(x), float32(y), scale, func(g glyph, x, y, width, height float32) {
+		w.addFontGlyph(&font.glyphAtlas, g, x, y, width, height, col)
+	})
+}
+
+// updateFontTexture creates the texture for the glyph atlas or creates it
+// again if the atlas changed. It returns false if that fails.
+func (w *window) updateFontTexture(atlas *glyphAtlas) bool {
+	if version, ok := w.fontVersions[atlas]; ok && version == atlas.version {
+		return true
+	}
+
+	// The old atlas might still be used by the backlog.
+	w.flushBacklog()
+	if old, ok := w.textures[atlas.key]; ok {
+		old.texture.Release()
+		delete(w.textures, atlas.key)
+	}
+	if err := w.createTexture(atlas.key, atlas.atlas); err != nil {
+		return false
+	}
+	if w.fontVersions == nil {
+		w.fontVersions = make(map[*glyphAtlas]int)
+	}
+	w.fontVersions[atlas] = atlas.version
+	return true
+}
+
+// addFontGlyph adds a glyph from the atlas to the text backlog.
+func (w *window) addFontGlyph(atlas *glyphAtlas, g glyph, x, y, width, height, col float32) {
+	atlasW := float32(atlas.atlas.Rect.Dx())
+	atlasH := float32(atlas.atlas.Rect.Dy())
+	u1, v1 := float32(g.x)/atlasW, float32(g.y)/atlasH
+	u2 := float32(g.x+g.width) / atlasW
+	v2 := float32(g.y+g.height) / atlasH
+	x1, y1 := x-0.5, y-0.5
+	x2, y2 := x+width-0.5, y+height-0.5
+
+	w.addTextBacklog(atlas.key,
+		x1, y1, 0, 1, col, u1, v1,
+		x2, y1, 0, 1, col, u2, v1,
+		x1, y2, 0, 1, col, u1, v2,
+
+		x1, y2, 0, 1, col, u1, v2,
+		x2, y1, 0, 1, col, u2, v1,
+		x2, y2, 0, 1, col, u2, v2,
+	)
+}
+
+func (w *window) DrawRichText(text string, x, y int, color Color) {
+	w.DrawScaledRichText(text, x, y, 1, color)
+}
+
+func (w *window) DrawScaledRichText(text string, x, y int, scale float32, color Color) {
+	drawRichText(
+		text, x, y, scale, color, w.GetScaledTextSize,
+		w.textEffects, w.drawPlainText, w.drawTextOutline,
+	)
+}
+
+func (w *window) GetRichTextSize(text string) (int, int) {
+	return w.GetScaledRichTextSize(text, 1)
+}
+
+func (w *window) GetScaledRichTextSize(text string, scale float32) (int, int) {
+	_, width, height := layoutRichText(text, scale, White, w.GetScaledTextSize)
+	return width, height
+}
+
+func (w *window) DrawTextBox(text string, x, y, width, height int, align TextAlign, color Color) {
+	for _, line := range layoutTextBox(text, width, height, align, w.GetTextSize) {
+		w.DrawText(line.text, x+line.x, y+line.y, color)
+	}
+}
+
+func (w *window) GetTextBoxSize(text string, width int) (int, int) {
+	return textBoxSize(text, width, w.GetTextSize)
+}
+
 func (w *window) PlaySoundFile(path string) error {
 	if !w.soundOn {
 		return errors.New("sound mixer could not be initialized")
@@ -1013,9 +2188,10 @@ func (w *window) mouseEvent(button MouseButton, down bool) {
 	w.mouseDown[button] = down
 
 	if down {
+		x, y := w.MousePosition()
 		w.clicks = append(w.clicks, MouseClick{
-			X:      w.mouse.x,
-			Y:      w.mouse.y,
+			X:      x,
+			Y:      y,
 			Button: button,
 		})
 		w32.SetCapture(w.handle)
@@ -1034,6 +2210,9 @@ func (w *window) finishFrame() {
 	w.wheelX = 0
 	w.wheelY = 0
 	w.text = ""
+	w.resized = false
+	w.gainedFocus = false
+	w.lostFocus = false
 }
 
 func colorToFloat32(color Color) float32 {
@@ -1113,6 +2292,11 @@ func (w *window) loadTexture(path string) error {
 }
 
 func (w *window) createTexture(path string, img image.Image) error {
+	var ninePatch ninePatchInsets
+	if isNinePatchFile(path) {
+		ninePatch, img = readNinePatch(img)
+	}
+
 	var nrgba *image.NRGBA
 	if i, ok := img.(*image.NRGBA); ok {
 		nrgba = i
@@ -1169,9 +2353,10 @@ func (w *window) createTexture(path string, img image.Image) error {
 	texture.GenerateMipSubLevels()
 
 	w.textures[path] = sizedTexture{
-		texture: texture,
-		width:   nrgba.Bounds().Dx(),
-		height:  nrgba.Bounds().Dy(),
+		texture:   texture,
+		width:     nrgba.Bounds().Dx(),
+		height:    nrgba.Bounds().Dy(),
+		ninePatch: ninePatch,
 	}
 
 	return nil
@@ -1180,6 +2365,7 @@ func (w *window) createTexture(path string, img image.Image) error {
 type sizedTexture struct {
 	texture       *d3d9.Texture
 	width, height int
+	ninePatch     ninePatchInsets
 }
 
 func (w *window) renderImage(
@@ -1187,6 +2373,7 @@ func (w *window) renderImage(
 	x, y, width, height int,
 	srcX, srcY, srcW, srcH int,
 	degrees int,
+	tint Color,
 ) error {
 	w.flushBacklog()
 
@@ -1209,7 +2396,7 @@ func (w *window) renderImage(
 		srcW, srcH = texture.width, texture.height
 	}
 
-	col := colorToFloat32(White)
+	col := colorToFloat32(tint)
 	fx, fy, fw, fh := float32(x), float32(y), float32(width), float32(height)
 
 	x1, y1 := -fw/2, -fh/2
@@ -1421,3 +2608,52 @@ func rawInputToKey(kb w32.RAWKEYBOARD) (key Key, down bool) {
 
 	return Key(0), false
 }
+
+const wmDPIChanged = 0x02E0
+
+var (
+	user32                        = syscall.NewLazyDLL("user32.dll")
+	setProcessDpiAwarenessContext = user32.NewProc("SetProcessDpiAwarenessContext")
+	setProcessDPIAware            = user32.NewProc("SetProcessDPIAware")
+	getDpiForWindow               = user32.NewProc("GetDpiForWindow")
+	flashWindowEx                 = user32.NewProc("FlashWindowEx")
+	getMonitorInfo                = user32.NewProc("GetMonitorInfoW")
+	enumDisplayDevices            = user32.NewProc("EnumDisplayDevicesW")
+)
+
+// enableHighDPI makes Windows stop scaling our window so we draw at the
+// native resolution. Newer versions of Windows support per-monitor DPI
+// awareness which also tells us when the window moves to a monitor with a
+// different scaling. Older versions only know about the system DPI.
+func enableHighDPI() {
+	if setProcessDpiAwarenessContext.Find() == nil {
+		// DPI_AWARENESS_CONTEXT_PER_MONITOR_AWARE_V2 is defined as -4.
+		const perMonitorAwareV2 = ^uintptr(3)
+		ok, _, _ := setProcessDpiAwarenessContext.Call(perMonitorAwareV2)
+		if ok != 0 {
+			return
+		}
+	}
+	if setProcessDPIAware.Find() == nil {
+		setProcessDPIAware.Call()
+	}
+}
+
+// systemContentScale returns the display scaling of the primary monitor. If
+// we are not DPI aware, Windows reports a scaled down screen resolution to us
+// but the desktop resolution is the real one.
+func systemContentScale() float32 {
+	dc := w32.GetDC(0)
+	defer w32.ReleaseDC(0, dc)
+
+	if HighDPI {
+		return float32(w32.GetDeviceCaps(dc, w32.LOGPIXELSX)) / 96
+	}
+
+	desktop := w32.GetDeviceCaps(dc, w32.DESKTOPHORZRES)
+	screen := w32.GetDeviceCaps(dc, w32.HORZRES)
+	if desktop <= 0 || screen <= 0 {
+		return 1
+	}
+	return float32(desktop) / float32(screen)
+}
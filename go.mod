@@ -5,7 +5,8 @@ go 1.16
 require (
 	github.com/gonutz/d3d9 v1.2.4
 	github.com/gonutz/gl v1.0.0
-	github.com/gonutz/glfw v1.0.2
 	github.com/gonutz/mixer v1.0.0
 	github.com/gonutz/w32/v2 v2.2.0
+	golang.org/x/image v0.5.0
+	golang.org/x/text v0.7.0
 )
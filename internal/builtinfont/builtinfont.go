@@ -0,0 +1,21 @@
+// Package builtinfont describes the layout of draw's built-in font bitmap. It
+// is shared by the draw package, which reads the bitmap, and the drawfont
+// command, which creates it.
+package builtinfont
+
+// Runes are the runes in the cells of the font bitmap, 16 per row, in order.
+// Cell 0 is unused and cells 32 to 126 hold printable ASCII.
+var Runes = []rune{
+	0, '☺', '☻', '♥', '♦', '♣', '♠', '•', '◘', '○', '◙', '♂', '♀', '♪', '♫', '☼',
+	'►', '◄', '↕', '‼', '¶', '§', '▬', '↨', '↑', '↓', '→', '←', '∟', '↔', '▲', '▼',
+	' ', '!', '"', '#', '$', '%', '&', '\'', '(', ')', '*', '+', ',', '-', '.', '/',
+	'0', '1', '2', '3', '4', '5', '6', '7', '8', '9', ':', ';', '<', '=', '>', '?',
+	'@', 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O',
+	'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z', '[', '\\', ']', '^', '_',
+	'`', 'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o',
+	'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z', '{', '|', '}', '~', '⌂',
+	'â', 'á', 'à', 'ê', 'é', 'è', 'î', 'í', 'ì', 'ô', 'ó', 'ò', 'û', 'ú', 'ù', 'Â',
+	'Á', 'À', 'Ê', 'É', 'È', 'Î', 'Í', 'Ì', 'Ô', 'Ó', 'Ò', 'Û', 'Ú', 'Ù', 'ä', 'ë',
+	'ï', 'ö', 'ü', 'Ä', 'Ë', 'Ï', 'Ö', 'Ü', 'å', 'ů', 'Å', 'Ů', 'ç', 'Ç', 'ß', '²',
+	'³', '´', '°', 'æ', 'Æ',
+}
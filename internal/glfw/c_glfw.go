@@ -0,0 +1,12 @@
+package glfw
+
+/*
+#include "glfw/src/context.c"
+#include "glfw/src/init.c"
+#include "glfw/src/input.c"
+#include "glfw/src/monitor.c"
+#include "glfw/src/vulkan.c"
+#include "glfw/src/window.c"
+#include "glfw/src/osmesa_context.c"
+*/
+import "C"
@@ -0,0 +1,36 @@
+package glfw
+
+//#define GLFW_EXPOSE_NATIVE_WIN32
+//#define GLFW_EXPOSE_NATIVE_WGL
+//#define GLFW_INCLUDE_NONE
+//#include "glfw/include/GLFW/glfw3.h"
+//#include "glfw/include/GLFW/glfw3native.h"
+import "C"
+
+// GetWin32Adapter returns the adapter device name of the monitor.
+func (m *Monitor) GetWin32Adapter() string {
+	ret := C.glfwGetWin32Adapter(m.data)
+	panicError()
+	return C.GoString(ret)
+}
+
+// GetWin32Monitor returns the display device name of the monitor.
+func (m *Monitor) GetWin32Monitor() string {
+	ret := C.glfwGetWin32Monitor(m.data)
+	panicError()
+	return C.GoString(ret)
+}
+
+// GetWin32Window returns the HWND of the window.
+func (w *Window) GetWin32Window() C.HWND {
+	ret := C.glfwGetWin32Window(w.data)
+	panicError()
+	return ret
+}
+
+// GetWGLContext returns the HGLRC of the window.
+func (w *Window) GetWGLContext() C.HGLRC {
+	ret := C.glfwGetWGLContext(w.data)
+	panicError()
+	return ret
+}
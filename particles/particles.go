@@ -0,0 +1,424 @@
+// Package particles provides particle emitters for effects like explosions,
+// sparks, smoke or rain.
+//
+// An Emitter spawns particles at its position, moves them and draws them to a
+// draw.Window. Call Update and Draw once per frame. What the particles look
+// like and how they move is described by Settings, which you can create in
+// code or load from a JSON file so you can tweak effects without re-compiling
+// your game. See LoadSettings for the file format.
+package particles
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/gonutz/prototype/draw"
+)
+
+// Shape is what a particle is drawn as.
+type Shape int
+
+const (
+	// Square particles are filled rectangles of size x size pixels.
+	Square Shape = iota
+	// Circle particles are filled circles with a diameter of size pixels.
+	Circle
+	// Point particles are single pixels, their size is ignored.
+	Point
+	// Image particles draw Settings.Image with a width of size pixels, keeping
+	// the image's aspect ratio. The image is tinted with the particle color.
+	Image
+)
+
+// Range is an interval from which random values are picked. If Min and Max
+// are the same, that value is always used.
+type Range struct {
+	Min, Max float64
+}
+
+// Between is a shortcut for creating a Range.
+func Between(min, max float64) Range {
+	return Range{Min: min, Max: max}
+}
+
+// Fixed is a Range that always yields the given value.
+func Fixed(value float64) Range {
+	return Range{Min: value, Max: value}
+}
+
+func (r Range) random() float64 {
+	return r.Min + rand.Float64()*(r.Max-r.Min)
+}
+
+// Settings describe how an Emitter spawns particles and how the particles
+// behave over their lifetime. All times are in seconds, distances in pixels
+// and angles in degrees, clockwise, with 0 pointing to the right.
+type Settings struct {
+	// Rate is the number of particles spawned per second. Set it to 0 for
+	// emitters that only spawn particles through Emitter.Burst.
+	Rate float64
+	// MaxParticles limits the number of live particles if it is > 0.
+	MaxParticles int
+	// SpawnWidth and SpawnHeight define a rectangle, centered around the
+	// emitter's position, in which particles are spawned at random. If they
+	// are 0, all particles spawn exactly at the emitter's position.
+	SpawnWidth, SpawnHeight float64
+	// Lifetime is the time that a particle lives.
+	Lifetime Range
+	// Speed is the initial speed in pixels per second.
+	Speed Range
+	// Angle is the initial direction of movement.
+	Angle Range
+	// GravityX and GravityY are the acceleration applied to all particles, in
+	// pixels per second squared.
+	GravityX, GravityY float64
+	// Damping slows particles down. It is the fraction of speed that is lost
+	// every second, 0 means no damping, 1 means particles stop right away.
+	Damping float64
+	// Colors is the particle color over its lifetime. The colors are spread
+	// evenly over the lifetime and blended in between, so a single color
+	// stays the same, two colors fade from the first to the second, etc. Use
+	// the alpha channel to fade particles in or out. Image particles multiply
+	// their image with the color, use white to only fade them.
+	Colors []draw.Color
+	// Sizes is the particle size over its lifetime, interpolated like
+	// Colors.
+	Sizes []float64
+	// Spin is the rotation speed of Image particles in degrees per second.
+	Spin  Range
+	Shape Shape
+	// Image is the path of the image file to draw for Image particles.
+	Image string
+}
+
+// Emitter spawns, moves and draws particles. Create it with NewEmitter.
+type Emitter struct {
+	Settings
+	// X and Y are the position at which new particles are spawned.
+	X, Y float64
+
+	particles    []particle
+	spawnDebt    float64
+	imageRatio   float64 // Height / width of imageRatioOf.
+	imageRatioOf string
+}
+
+type particle struct {
+	x, y     float64
+	vx, vy   float64
+	age      float64
+	lifetime float64
+	rotation float64
+	spin     float64
+}
+
+// NewEmitter creates an emitter at the given position. The settings are
+// copied, so you can use the same settings for multiple emitters.
+func NewEmitter(settings Settings, x, y float64) *Emitter {
+	return &Emitter{Settings: settings, X: x, Y: y}
+}
+
+// Count returns the number of live particles.
+func (e *Emitter) Count() int {
+	return len(e.particles)
+}
+
+// IsDone reports whether the emitter has no live particles and will not spawn
+// any new ones by itself, i.e. its Rate is 0. Use this to remove one-shot
+// effects once they are over.
+func (e *Emitter) IsDone() bool {
+	return len(e.particles) == 0 && e.Rate <= 0
+}
+
+// Burst spawns n particles at once.
+func (e *Emitter) Burst(n int) {
+	for i := 0; i < n; i++ {
+		e.spawn()
+	}
+}
+
+// Clear removes all live particles.
+func (e *Emitter) Clear() {
+	e.particles = e.particles[:0]
+	e.spawnDebt = 0
+}
+
+// Update advances all particles by dt seconds and spawns new ones according
+// to the emitter's Rate. Pass Window.DeltaTime as dt to have particles move
+// at the same speed at any frame rate.
+func (e *Emitter) Update(dt float64) {
+	live := e.particles[:0] // Reuse the array.
+	for _, p := range e.particles {
+		p.age += dt
+		if p.age >= p.lifetime {
+			continue
+		}
+		if e.Damping > 0 {
+			f := math.Max(0, 1-e.Damping*dt)
+			p.vx *= f
+			p.vy *= f
+		}
+		p.vx += e.GravityX * dt
+		p.vy += e.GravityY * dt
+		p.x += p.vx * dt
+		p.y += p.vy * dt
+		p.rotation += p.spin * dt
+		live = append(live, p)
+	}
+	e.particles = live
+
+	if e.Rate > 0 {
+		e.spawnDebt += e.Rate * dt
+		for e.spawnDebt >= 1 {
+			e.spawn()
+			e.spawnDebt--
+		}
+	}
+}
+
+func (e *Emitter) spawn() {
+	if e.MaxParticles > 0 && len(e.particles) >= e.MaxParticles {
+		return
+	}
+
+	speed := e.Speed.random()
+	sin, cos := math.Sincos(e.Angle.random() * math.Pi / 180)
+	e.particles = append(e.particles, particle{
+		x:        e.X + (rand.Float64()-0.5)*e.SpawnWidth,
+		y:        e.Y + (rand.Float64()-0.5)*e.SpawnHeight,
+		vx:       cos * speed,
+		vy:       sin * speed,
+		lifetime: e.Lifetime.random(),
+		spin:     e.Spin.random(),
+	})
+}
+
+// Draw draws all live particles. It only returns an error for Image particles
+// if the image cannot be drawn.
+func (e *Emitter) Draw(window draw.Window) error {
+	if e.Shape == Image && len(e.particles) > 0 && e.imageRatioOf != e.Image {
+		w, h, err := window.ImageSize(e.Image)
+		if err != nil {
+			return err
+		}
+		if w > 0 {
+			e.imageRatio = float64(h) / float64(w)
+		}
+		e.imageRatioOf = e.Image
+	}
+
+	for _, p := range e.particles {
+		t := 0.0
+		if p.lifetime > 0 {
+			t = p.age / p.lifetime
+		}
+		size := lerpSizes(e.Sizes, t)
+		color := lerpColors(e.Colors, t)
+		x, y := round(p.x-size/2), round(p.y-size/2)
+		s := round(size)
+
+		switch e.Shape {
+		case Square:
+			window.FillRect(x, y, s, s, color)
+		case Circle:
+			window.FillEllipse(x, y, s, s, color)
+		case Point:
+			window.DrawPoint(round(p.x), round(p.y), color)
+		case Image:
+			h := round(size * e.imageRatio)
+			err := window.DrawImageFileTinted(
+				e.Image,
+				round(p.x-size/2), round(p.y-float64(h)/2), s, h,
+				round(p.rotation), color,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func lerpSizes(sizes []float64, t float64) float64 {
+	if len(sizes) == 0 {
+		return 1
+	}
+	i, f := keyFrame(len(sizes), t)
+	if f == 0 {
+		return sizes[i]
+	}
+	return sizes[i] + f*(sizes[i+1]-sizes[i])
+}
+
+func lerpColors(colors []draw.Color, t float64) draw.Color {
+	if len(colors) == 0 {
+		return draw.White
+	}
+	i, f := keyFrame(len(colors), t)
+	if f == 0 {
+		return colors[i]
+	}
+	a, b := colors[i], colors[i+1]
+	f32 := float32(f)
+	return draw.Color{
+		R: a.R + f32*(b.R-a.R),
+		G: a.G + f32*(b.G-a.G),
+		B: a.B + f32*(b.B-a.B),
+		A: a.A + f32*(b.A-a.A),
+	}
+}
+
+// keyFrame returns the index of the key frame before t and how far t is on
+// the way to the next key frame, for n evenly spaced key frames.
+func keyFrame(n int, t float64) (index int, fraction float64) {
+	if n == 1 || t <= 0 {
+		return 0, 0
+	}
+	if t >= 1 {
+		return n - 1, 0
+	}
+	pos := t * float64(n-1)
+	index = int(pos)
+	return index, pos - float64(index)
+}
+
+func round(x float64) int {
+	return int(math.Floor(x + 0.5))
+}
+
+// LoadSettings reads emitter settings from a JSON file. The file is read
+// through draw.ReadFile. All fields are optional, here is an example:
+//
+//	{
+//	    "rate": 0,
+//	    "maxParticles": 500,
+//	    "spawnArea": [10, 10],
+//	    "lifetime": [0.5, 1.2],
+//	    "speed": [40, 160],
+//	    "angle": [0, 360],
+//	    "gravity": [0, 200],
+//	    "damping": 0.5,
+//	    "colors": ["#FFFFA0", "#FF8000", "#80000000"],
+//	    "sizes": [6, 2],
+//	    "spin": [-180, 180],
+//	    "shape": "circle",
+//	    "image": "spark.png"
+//	}
+//
+// Ranges like "lifetime" can be given as a [min, max] pair or as a single
+// number. Colors are hex strings of the form "#RRGGBB" or "#RRGGBBAA". Valid
+// shapes are "square", "circle", "point" and "image".
+func LoadSettings(path string) (Settings, error) {
+	data, err := draw.ReadFile(path)
+	if err != nil {
+		return Settings{}, err
+	}
+
+	s, err := parseSettings(data)
+	if err != nil {
+		return Settings{}, errors.New("particles: " + path + ": " + err.Error())
+	}
+	return s, nil
+}
+
+type settingsFile struct {
+	Rate         float64    `json:"rate"`
+	MaxParticles int        `json:"maxParticles"`
+	SpawnArea    [2]float64 `json:"spawnArea"`
+	Lifetime     Range      `json:"lifetime"`
+	Speed        Range      `json:"speed"`
+	Angle        Range      `json:"angle"`
+	Gravity      [2]float64 `json:"gravity"`
+	Damping      float64    `json:"damping"`
+	Colors       []string   `json:"colors"`
+	Sizes        []float64  `json:"sizes"`
+	Spin         Range      `json:"spin"`
+	Shape        string     `json:"shape"`
+	Image        string     `json:"image"`
+}
+
+func parseSettings(data []byte) (Settings, error) {
+	var f settingsFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return Settings{}, err
+	}
+
+	s := Settings{
+		Rate:         f.Rate,
+		MaxParticles: f.MaxParticles,
+		SpawnWidth:   f.SpawnArea[0],
+		SpawnHeight:  f.SpawnArea[1],
+		Lifetime:     f.Lifetime,
+		Speed:        f.Speed,
+		Angle:        f.Angle,
+		GravityX:     f.Gravity[0],
+		GravityY:     f.Gravity[1],
+		Damping:      f.Damping,
+		Sizes:        f.Sizes,
+		Spin:         f.Spin,
+		Image:        f.Image,
+	}
+
+	for _, c := range f.Colors {
+		color, err := parseColor(c)
+		if err != nil {
+			return Settings{}, err
+		}
+		s.Colors = append(s.Colors, color)
+	}
+
+	switch strings.ToLower(f.Shape) {
+	case "", "square":
+		s.Shape = Square
+	case "circle":
+		s.Shape = Circle
+	case "point":
+		s.Shape = Point
+	case "image":
+		s.Shape = Image
+	default:
+		return Settings{}, errors.New("unknown shape: " + f.Shape)
+	}
+
+	return s, nil
+}
+
+// UnmarshalJSON accepts either a single number or a [min, max] pair.
+func (r *Range) UnmarshalJSON(data []byte) error {
+	var single float64
+	if err := json.Unmarshal(data, &single); err == nil {
+		*r = Fixed(single)
+		return nil
+	}
+
+	var pair []float64
+	if err := json.Unmarshal(data, &pair); err != nil || len(pair) != 2 {
+		return errors.New("range must be a number or a [min, max] pair: " + string(data))
+	}
+	*r = Between(pair[0], pair[1])
+	return nil
+}
+
+func parseColor(s string) (draw.Color, error) {
+	hex := strings.TrimPrefix(s, "#")
+	if len(hex) != 6 && len(hex) != 8 {
+		return draw.Color{}, errors.New("colors must be #RRGGBB or #RRGGBBAA: " + s)
+	}
+	if len(hex) == 6 {
+		hex += "FF"
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return draw.Color{}, errors.New("invalid color: " + s)
+	}
+	return draw.RGBA(
+		float32(v>>24&0xFF)/255,
+		float32(v>>16&0xFF)/255,
+		float32(v>>8&0xFF)/255,
+		float32(v&0xFF)/255,
+	), nil
+}
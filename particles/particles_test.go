@@ -0,0 +1,145 @@
+package particles
+
+import (
+	"testing"
+
+	"github.com/gonutz/prototype/draw"
+)
+
+func TestParticlesDieAfterTheirLifetime(t *testing.T) {
+	e := NewEmitter(Settings{Lifetime: Between(0.5, 1)}, 0, 0)
+	e.Burst(100)
+	if e.Count() != 100 {
+		t.Fatalf("want 100 particles after burst but have %d", e.Count())
+	}
+
+	e.Update(0.49)
+	if e.Count() != 100 {
+		t.Errorf("no particle should have died yet but %d are left", e.Count())
+	}
+
+	e.Update(0.52)
+	if !e.IsDone() {
+		t.Errorf("all particles should be dead but %d are left", e.Count())
+	}
+}
+
+func TestRateSpawnsParticlesOverTime(t *testing.T) {
+	e := NewEmitter(Settings{Rate: 30, Lifetime: Fixed(10), MaxParticles: 50}, 0, 0)
+	for i := 0; i < 60; i++ {
+		e.Update(1.0 / 60)
+	}
+	if e.Count() != 30 {
+		t.Errorf("want 30 particles after one second but have %d", e.Count())
+	}
+
+	for i := 0; i < 60; i++ {
+		e.Update(1.0 / 60)
+	}
+	if e.Count() != 50 {
+		t.Errorf("want particles limited to 50 but have %d", e.Count())
+	}
+}
+
+func TestGravityAcceleratesParticles(t *testing.T) {
+	e := NewEmitter(Settings{Lifetime: Fixed(10), GravityY: 10}, 5, 5)
+	e.Burst(1)
+	e.Update(1)
+	p := e.particles[0]
+	if p.x != 5 || p.vy != 10 || p.y != 15 {
+		t.Errorf("wrong particle after one second: %+v", p)
+	}
+}
+
+func TestKeyFramesAreInterpolated(t *testing.T) {
+	sizes := []float64{2, 10, 4}
+	checkFloat(t, lerpSizes(sizes, 0), 2)
+	checkFloat(t, lerpSizes(sizes, 0.25), 6)
+	checkFloat(t, lerpSizes(sizes, 0.5), 10)
+	checkFloat(t, lerpSizes(sizes, 1), 4)
+	checkFloat(t, lerpSizes(sizes[:1], 0.7), 2)
+	checkFloat(t, lerpSizes(nil, 0.7), 1)
+
+	c := lerpColors([]draw.Color{draw.Black, draw.RGBA(1, 0.5, 0, 0)}, 0.5)
+	if c != draw.RGBA(0.5, 0.25, 0, 0.5) {
+		t.Errorf("wrong color %v", c)
+	}
+}
+
+func TestImageParticlesAreTintedWithTheirColor(t *testing.T) {
+	e := NewEmitter(Settings{
+		Lifetime: Fixed(1),
+		Colors:   []draw.Color{draw.White, draw.RGBA(1, 0, 0, 0)},
+		Sizes:    []float64{8},
+		Shape:    Image,
+		Image:    "spark.png",
+	}, 0, 0)
+	e.Burst(1)
+	e.Update(0.5)
+
+	var w imageWindow
+	if err := e.Draw(&w); err != nil {
+		t.Fatal(err)
+	}
+	if len(w.tints) != 1 || w.tints[0] != draw.RGBA(1, 0.5, 0.5, 0.5) {
+		t.Errorf("want the image drawn half faded to red but have %v", w.tints)
+	}
+}
+
+// imageWindow records the tint colors of the images drawn to it.
+type imageWindow struct {
+	draw.Window
+	tints []draw.Color
+}
+
+func (w *imageWindow) ImageSize(path string) (int, int, error) {
+	return 4, 4, nil
+}
+
+func (w *imageWindow) DrawImageFileTinted(path string, x, y, width, height, rotation int, tint draw.Color) error {
+	w.tints = append(w.tints, tint)
+	return nil
+}
+
+func TestSettingsFile(t *testing.T) {
+	s, err := parseSettings([]byte(`{
+		"rate": 20,
+		"lifetime": [0.5, 1.5],
+		"speed": 100,
+		"gravity": [1, 2],
+		"spawnArea": [3, 4],
+		"colors": ["#FF0000", "#00FF0080"],
+		"sizes": [4, 1],
+		"shape": "Circle"
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Rate != 20 || s.Lifetime != Between(0.5, 1.5) || s.Speed != Fixed(100) ||
+		s.GravityX != 1 || s.GravityY != 2 ||
+		s.SpawnWidth != 3 || s.SpawnHeight != 4 || s.Shape != Circle {
+		t.Errorf("wrong settings: %+v", s)
+	}
+	if len(s.Colors) != 2 || s.Colors[0] != draw.Red ||
+		s.Colors[1] != draw.RGBA(0, 1, 0, 128.0/255) {
+		t.Errorf("wrong colors: %v", s.Colors)
+	}
+
+	for _, bad := range []string{
+		`{"colors": ["red"]}`,
+		`{"shape": "star"}`,
+		`{"speed": [1, 2, 3]}`,
+	} {
+		if _, err := parseSettings([]byte(bad)); err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+	}
+}
+
+func checkFloat(t *testing.T, have, want float64) {
+	t.Helper()
+	if have != want {
+		t.Errorf("want %v but have %v", want, have)
+	}
+}
@@ -1,6 +1,9 @@
 package main
 
-import "github.com/gonutz/prototype/draw"
+import (
+	"github.com/gonutz/prototype/draw"
+	"github.com/gonutz/prototype/particles"
+)
 
 const (
 	windowWidth        = 640
@@ -15,6 +18,11 @@ const (
 )
 
 func main() {
+	explosion, err := particles.LoadSettings("explosion.json")
+	if err != nil {
+		panic(err)
+	}
+
 	var (
 		ship              spaceShip
 		bullets           []bullet
@@ -24,6 +32,7 @@ func main() {
 		nextPatternChange int
 		gameOver          bool
 		gameOverText      string
+		explosions        []*particles.Emitter
 
 		// Enemies move left, down, right, down, left, down, ...
 		movePatterns = []struct{ dx, dy int }{
@@ -47,10 +56,11 @@ func main() {
 		nextPatternChange = patternChangeDelay
 		gameOver = false
 		gameOverText = ""
+		explosions = nil
 	}
 	newGame()
 
-	err := draw.RunWindow("Space Shooter", windowWidth, windowHeight, func(window draw.Window) {
+	err = draw.RunWindow("Space Shooter", windowWidth, windowHeight, func(window draw.Window) {
 		if window.WasKeyPressed(draw.KeyEscape) {
 			window.Close()
 		}
@@ -81,7 +91,14 @@ func main() {
 			for i := range bullets {
 				bullets[i].y -= bulletSpeed
 				for j := range enemies {
-					bullets[i].collide(&enemies[j], window)
+					e := &enemies[j]
+					if bullets[i].collide(e, window) {
+						explosions = append(
+							explosions,
+							particles.NewEmitter(explosion, float64(e.x), float64(e.y)),
+						)
+						explosions[len(explosions)-1].Burst(80)
+					}
 				}
 			}
 			bullets = removeDeadBullets(bullets)
@@ -108,6 +125,15 @@ func main() {
 			}
 		}
 
+		liveExplosions := explosions[:0] // Reuse the array.
+		for _, e := range explosions {
+			e.Update(window.DeltaTime())
+			if !e.IsDone() {
+				liveExplosions = append(liveExplosions, e)
+			}
+		}
+		explosions = liveExplosions
+
 		window.FillEllipse(ship.x, ship.y, shipSize, shipSize, draw.Red)
 		for _, b := range bullets {
 			window.FillEllipse(b.x-bulletSize/2, b.y-bulletSize/2, bulletSize, bulletSize, draw.Green)
@@ -115,6 +141,9 @@ func main() {
 		for _, e := range enemies {
 			window.FillRect(e.x-enemySize/2, e.y-enemySize/2, enemySize, enemySize, e.color)
 		}
+		for _, e := range explosions {
+			e.Draw(window)
+		}
 		window.DrawScaledText(gameOverText, 100, 180, 2.0, draw.White)
 	})
 
@@ -158,17 +187,19 @@ type bullet struct {
 	dead bool
 }
 
-func (b *bullet) collide(e *enemy, window draw.Window) {
+// collide reports whether the bullet destroyed the enemy.
+func (b *bullet) collide(e *enemy, window draw.Window) bool {
 	squareDist := square(b.x-e.x) + square(b.y-e.y)
 	if squareDist <= square(enemySize/2+bulletSize/2) {
 		e.life--
 		b.dead = true
 		if e.life == 0 {
 			window.PlaySoundFile("explosion.wav")
-		} else {
-			window.PlaySoundFile("hit.wav")
+			return true
 		}
+		window.PlaySoundFile("hit.wav")
 	}
+	return false
 }
 
 func square(x int) int {
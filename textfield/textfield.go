@@ -0,0 +1,588 @@
+// Package textfield provides a text input field for a draw.Window, e.g. to
+// enter a name for the high score list or to type chat messages.
+//
+// Create a Field with New, then call its Update and Draw methods every frame:
+//
+//	name := textfield.New(100, 50, 200, 24)
+//	name.MaxLength = 12
+//	name.Focused = true
+//
+//	draw.RunWindow("High Score", 400, 300, func(window draw.Window) {
+//	    if name.Update(window) {
+//	        saveHighScore(name.Text())
+//	    }
+//	    name.Draw(window)
+//	})
+//
+// The field supports a blinking caret, the arrow keys, Home and End, selecting
+// text with Shift or the mouse, Backspace and Delete, Ctrl+A to select all
+// text and Ctrl+C, Ctrl+X and Ctrl+V to copy, cut and paste.
+package textfield
+
+import (
+	"math"
+
+	"github.com/gonutz/prototype/draw"
+)
+
+const (
+	// padding is the space between the field's border and its text.
+	padding = 4
+	// caretBlinkTime is the time in seconds that the caret is visible and
+	// then hidden.
+	caretBlinkTime = 0.5
+	// repeatDelay is the time in seconds that a key has to be held before it
+	// repeats and repeatInterval is the time between repeats.
+	repeatDelay    = 0.5
+	repeatInterval = 0.04
+)
+
+// Field is a text input field. Set its fields to configure it, all of them can
+// be changed at any time.
+type Field struct {
+	// X, Y, Width and Height are the field's rectangle on the screen.
+	X, Y, Width, Height int
+	// MultiLine lets the user enter line breaks with Enter. Lines are not
+	// wrapped, the text scrolls to keep the caret visible.
+	MultiLine bool
+	// MaxLength is the maximum number of characters in the text. 0 means no
+	// limit.
+	MaxLength int
+	// Scale is the text scale, see draw.Window.DrawScaledText. 0 means 1.
+	Scale float32
+	// Focused fields receive keyboard input. Clicking a field focuses it,
+	// clicking elsewhere removes the focus.
+	Focused bool
+
+	TextColor       draw.Color
+	BackgroundColor draw.Color
+	BorderColor     draw.Color
+	SelectionColor  draw.Color
+	CaretColor      draw.Color
+
+	text []rune
+	// caret is the index of the rune before which the caret is. The text
+	// between anchor and caret is selected.
+	caret, anchor int
+	// scrollX and scrollY are how far the text is scrolled, in pixels.
+	scrollX, scrollY int
+	blinkTime        float64
+	dragging         bool
+	repeatKey        draw.Key
+	repeatTime       float64
+}
+
+// New creates an empty single-line field with a dark background and white
+// text.
+func New(x, y, width, height int) *Field {
+	return &Field{
+		X:               x,
+		Y:               y,
+		Width:           width,
+		Height:          height,
+		TextColor:       draw.White,
+		BackgroundColor: draw.RGB(0.1, 0.1, 0.1),
+		BorderColor:     draw.Gray,
+		SelectionColor:  draw.RGBA(0.2, 0.4, 0.9, 0.6),
+		CaretColor:      draw.White,
+	}
+}
+
+// Text returns the field's text. Lines are separated by '\n'.
+func (f *Field) Text() string {
+	return string(f.text)
+}
+
+// SetText replaces the field's text and puts the caret at its end. It is cut
+// to MaxLength and line breaks are removed unless the field is MultiLine.
+func (f *Field) SetText(text string) {
+	f.text = nil
+	f.caret = 0
+	f.anchor = 0
+	f.insert(text)
+}
+
+// SelectAll selects the whole text.
+func (f *Field) SelectAll() {
+	f.anchor = 0
+	f.caret = len(f.text)
+}
+
+// Selection returns the selected part of the text, as rune indices. If
+// nothing is selected, start and end are the caret position.
+func (f *Field) Selection() (start, end int) {
+	if f.anchor < f.caret {
+		return f.anchor, f.caret
+	}
+	return f.caret, f.anchor
+}
+
+// SelectedText returns the selected part of the text.
+func (f *Field) SelectedText() string {
+	start, end := f.Selection()
+	return string(f.text[start:end])
+}
+
+// Update handles the user input of the last frame. It returns true when Enter
+// is pressed in a focused single-line field.
+func (f *Field) Update(window draw.Window) (entered bool) {
+	dt := window.DeltaTime()
+	f.blinkTime += dt
+	f.updateMouse(window)
+	if !f.Focused {
+		f.repeatKey = 0
+		return false
+	}
+
+	// Held keys repeat after a delay.
+	repeated, repeats := f.repeatKey, 0
+	if repeated != 0 {
+		if window.IsKeyDown(repeated) {
+			f.repeatTime -= dt
+			for f.repeatTime <= 0 {
+				repeats++
+				f.repeatTime += repeatInterval
+			}
+		} else {
+			f.repeatKey = 0
+		}
+	}
+	times := func(key draw.Key) int {
+		n := 0
+		if key == repeated {
+			n = repeats
+		}
+		if window.WasKeyPressed(key) {
+			n++
+			f.repeatKey = key
+			f.repeatTime = repeatDelay
+		}
+		return n
+	}
+
+	shift := window.IsKeyDown(draw.KeyLeftShift) || window.IsKeyDown(draw.KeyRightShift)
+	ctrl := window.IsKeyDown(draw.KeyLeftControl) || window.IsKeyDown(draw.KeyRightControl)
+	if window.IsKeyDown(draw.KeyLeftAlt) || window.IsKeyDown(draw.KeyRightAlt) {
+		// AltGr is reported as Ctrl+Alt on Windows. It types characters like
+		// @ or { on many keyboard layouts, so Ctrl+Alt is no shortcut.
+		ctrl = false
+	}
+	before := *f
+
+	if ctrl {
+		if window.WasKeyPressed(draw.KeyA) {
+			f.SelectAll()
+		}
+		if window.WasKeyPressed(draw.KeyC) || window.WasKeyPressed(draw.KeyX) {
+			if start, end := f.Selection(); start != end {
+				window.SetClipboardText(f.SelectedText())
+				if window.WasKeyPressed(draw.KeyX) {
+					f.deleteSelection()
+				}
+			}
+		}
+		if window.WasKeyPressed(draw.KeyV) {
+			f.insert(window.ClipboardText())
+		}
+	} else if chars := window.Characters(); chars != "" {
+		f.insert(chars)
+	}
+
+	for n := times(draw.KeyBackspace); n > 0; n-- {
+		if f.anchor == f.caret && f.caret > 0 {
+			f.anchor = f.caret - 1
+		}
+		f.deleteSelection()
+	}
+	for n := times(draw.KeyDelete); n > 0; n-- {
+		if f.anchor == f.caret && f.caret < len(f.text) {
+			f.anchor = f.caret + 1
+		}
+		f.deleteSelection()
+	}
+	for n := times(draw.KeyLeft); n > 0; n-- {
+		start, end := f.Selection()
+		if start != end && !shift {
+			f.moveCaret(start, false)
+		} else {
+			f.moveCaret(f.caret-1, shift)
+		}
+	}
+	for n := times(draw.KeyRight); n > 0; n-- {
+		start, end := f.Selection()
+		if start != end && !shift {
+			f.moveCaret(end, false)
+		} else {
+			f.moveCaret(f.caret+1, shift)
+		}
+	}
+	for n := times(draw.KeyHome); n > 0; n-- {
+		if ctrl || !f.MultiLine {
+			f.moveCaret(0, shift)
+		} else {
+			line, _ := f.position(f.caret)
+			f.moveCaret(f.index(line, 0), shift)
+		}
+	}
+	for n := times(draw.KeyEnd); n > 0; n-- {
+		if ctrl || !f.MultiLine {
+			f.moveCaret(len(f.text), shift)
+		} else {
+			line, _ := f.position(f.caret)
+			f.moveCaret(f.index(line, math.MaxInt32), shift)
+		}
+	}
+	for n := times(draw.KeyUp); n > 0; n-- {
+		f.moveCaretLines(window, -1, shift)
+	}
+	for n := times(draw.KeyDown); n > 0; n-- {
+		f.moveCaretLines(window, 1, shift)
+	}
+	for n := times(draw.KeyEnter) + times(draw.KeyNumEnter); n > 0; n-- {
+		if f.MultiLine {
+			f.insert("\n")
+		} else {
+			entered = true
+		}
+	}
+
+	if f.caret != before.caret || f.anchor != before.anchor || len(f.text) != len(before.text) {
+		// The caret stays visible while the user is typing.
+		f.blinkTime = 0
+	}
+	f.scrollToCaret(window)
+	return entered
+}
+
+// updateMouse focuses the field and places the caret when it is clicked and
+// selects text while the mouse is dragged.
+func (f *Field) updateMouse(window draw.Window) {
+	shift := window.IsKeyDown(draw.KeyLeftShift) || window.IsKeyDown(draw.KeyRightShift)
+	for _, click := range window.Clicks() {
+		if click.Button != draw.LeftButton {
+			continue
+		}
+		if f.contains(click.X, click.Y) {
+			f.Focused = true
+			f.dragging = true
+			f.moveCaret(f.indexAt(window, click.X, click.Y), shift)
+			f.blinkTime = 0
+		} else {
+			f.Focused = false
+			f.dragging = false
+		}
+	}
+
+	if f.dragging {
+		if window.IsMouseDown(draw.LeftButton) {
+			x, y := window.MousePosition()
+			f.moveCaret(f.indexAt(window, x, y), true)
+		} else {
+			f.dragging = false
+		}
+	}
+}
+
+func (f *Field) contains(x, y int) bool {
+	return f.X <= x && x < f.X+f.Width && f.Y <= y && y < f.Y+f.Height
+}
+
+// insert replaces the selection with the text, leaving out what does not fit
+// into MaxLength and characters that cannot be entered.
+func (f *Field) insert(text string) {
+	f.deleteSelection()
+	var runes []rune
+	for _, r := range text {
+		if r == '\r' {
+			continue
+		}
+		if r == '\n' && !f.MultiLine || r != '\n' && (r < ' ' || r == 127) {
+			continue
+		}
+		if f.MaxLength > 0 && len(f.text)+len(runes) >= f.MaxLength {
+			break
+		}
+		runes = append(runes, r)
+	}
+
+	text2 := make([]rune, 0, len(f.text)+len(runes))
+	text2 = append(text2, f.text[:f.caret]...)
+	text2 = append(text2, runes...)
+	text2 = append(text2, f.text[f.caret:]...)
+	f.text = text2
+	f.caret += len(runes)
+	f.anchor = f.caret
+}
+
+func (f *Field) deleteSelection() {
+	start, end := f.Selection()
+	f.text = append(f.text[:start], f.text[end:]...)
+	f.caret = start
+	f.anchor = start
+}
+
+// moveCaret puts the caret before the rune at index i. If extend is true, the
+// selection is extended to there, otherwise it is removed.
+func (f *Field) moveCaret(i int, extend bool) {
+	if i < 0 {
+		i = 0
+	}
+	if i > len(f.text) {
+		i = len(f.text)
+	}
+	f.caret = i
+	if !extend {
+		f.anchor = i
+	}
+}
+
+// moveCaretLines moves the caret up or down by the given number of lines,
+// keeping it as close to its horizontal position as possible. Single-line
+// fields move the caret to the start or end instead.
+func (f *Field) moveCaretLines(window draw.Window, lines int, extend bool) {
+	if !f.MultiLine {
+		if lines < 0 {
+			f.moveCaret(0, extend)
+		} else {
+			f.moveCaret(len(f.text), extend)
+		}
+		return
+	}
+
+	line, col := f.position(f.caret)
+	target := line + lines
+	if target < 0 {
+		f.moveCaret(0, extend)
+		return
+	}
+	if target >= len(f.lines()) {
+		f.moveCaret(len(f.text), extend)
+		return
+	}
+	x, _ := window.GetScaledTextSize(string(f.lines()[line][:col]), f.scale())
+	f.moveCaret(f.index(target, f.columnAt(window, target, x)), extend)
+}
+
+func (f *Field) scale() float32 {
+	if f.Scale <= 0 {
+		return 1
+	}
+	return f.Scale
+}
+
+// lines returns the text split at line breaks.
+func (f *Field) lines() [][]rune {
+	var lines [][]rune
+	start := 0
+	for i, r := range f.text {
+		if r == '\n' {
+			lines = append(lines, f.text[start:i])
+			start = i + 1
+		}
+	}
+	return append(lines, f.text[start:])
+}
+
+// position returns the line and column of the rune at index i.
+func (f *Field) position(i int) (line, col int) {
+	for _, r := range f.text[:i] {
+		col++
+		if r == '\n' {
+			line++
+			col = 0
+		}
+	}
+	return line, col
+}
+
+// index returns the index of the rune at the line and column. Columns past
+// the end of the line are at its end.
+func (f *Field) index(line, col int) int {
+	lines := f.lines()
+	i := 0
+	for _, l := range lines[:line] {
+		i += len(l) + 1
+	}
+	if col > len(lines[line]) {
+		col = len(lines[line])
+	}
+	return i + col
+}
+
+// lineHeight returns the height of a line of text.
+func (f *Field) lineHeight(window draw.Window) int {
+	_, h := window.GetScaledTextSize("", f.scale())
+	return h
+}
+
+// textOrigin returns the screen position of the text's top-left corner,
+// taking the scrolling into account.
+func (f *Field) textOrigin(window draw.Window) (x, y int) {
+	x = f.X + padding - f.scrollX
+	if f.MultiLine {
+		y = f.Y + padding - f.scrollY
+	} else {
+		// A single line is centered vertically.
+		y = f.Y + (f.Height-f.lineHeight(window))/2
+	}
+	return x, y
+}
+
+// indexAt returns the index of the rune boundary closest to the screen
+// position.
+func (f *Field) indexAt(window draw.Window, x, y int) int {
+	originX, originY := f.textOrigin(window)
+	line := 0
+	if f.MultiLine {
+		if h := f.lineHeight(window); h > 0 {
+			line = (y - originY) / h
+			if y < originY {
+				line = -1
+			}
+		}
+		if line < 0 {
+			line = 0
+		}
+		if n := len(f.lines()); line >= n {
+			line = n - 1
+		}
+	}
+	return f.index(line, f.columnAt(window, line, x-originX))
+}
+
+// columnAt returns the column in the line that is closest to x, relative to
+// the start of the line.
+func (f *Field) columnAt(window draw.Window, line, x int) int {
+	runes := f.lines()[line]
+	prevX := 0
+	for col := 1; col <= len(runes); col++ {
+		colX, _ := window.GetScaledTextSize(string(runes[:col]), f.scale())
+		if x < (prevX+colX)/2 {
+			return col - 1
+		}
+		prevX = colX
+	}
+	return len(runes)
+}
+
+// scrollToCaret scrolls the text so the caret is inside the field.
+func (f *Field) scrollToCaret(window draw.Window) {
+	line, col := f.position(f.caret)
+	caretX, _ := window.GetScaledTextSize(string(f.lines()[line][:col]), f.scale())
+	innerW := f.Width - 2*padding
+	if caretX-f.scrollX > innerW {
+		f.scrollX = caretX - innerW
+	}
+	if caretX < f.scrollX {
+		f.scrollX = caretX
+	}
+
+	if f.MultiLine {
+		h := f.lineHeight(window)
+		innerH := f.Height - 2*padding
+		top := line * h
+		if top+h-f.scrollY > innerH {
+			f.scrollY = top + h - innerH
+		}
+		if top < f.scrollY {
+			f.scrollY = top
+		}
+	} else {
+		f.scrollY = 0
+	}
+}
+
+// Draw draws the field with its text, selection and, if it is focused, its
+// blinking caret. Only characters that are completely inside the field are
+// drawn.
+func (f *Field) Draw(window draw.Window) {
+	window.FillRect(f.X, f.Y, f.Width, f.Height, f.BackgroundColor)
+	window.DrawRect(f.X, f.Y, f.Width, f.Height, f.BorderColor)
+
+	scale := f.scale()
+	h := f.lineHeight(window)
+	originX, originY := f.textOrigin(window)
+	left, right := f.X+padding, f.X+f.Width-padding
+	top, bottom := f.Y+padding, f.Y+f.Height-padding
+	if !f.MultiLine {
+		top, bottom = originY, originY+h
+	}
+	selStart, selEnd := f.Selection()
+
+	lineStart := 0
+	for i, line := range f.lines() {
+		y := originY + i*h
+		if y < top || y+h > bottom {
+			lineStart += len(line) + 1
+			continue
+		}
+
+		// xs are the positions of the rune boundaries in the line.
+		xs := make([]int, len(line)+1)
+		for col := range line {
+			xs[col+1], _ = window.GetScaledTextSize(string(line[:col+1]), scale)
+		}
+		clamp := func(x int) int {
+			if x < left {
+				return left
+			}
+			if x > right {
+				return right
+			}
+			return x
+		}
+
+		// The selection includes the line break at the end of a line.
+		from := maxInt(selStart-lineStart, 0)
+		to := selEnd - lineStart
+		if from <= len(line) && to > 0 {
+			x1 := originX + xs[minInt(from, len(line))]
+			x2 := originX + xs[minInt(to, len(line))]
+			if to > len(line) {
+				x2 += h / 4
+			}
+			x1, x2 = clamp(x1), clamp(x2)
+			if x2 > x1 {
+				window.FillRect(x1, y, x2-x1, h, f.SelectionColor)
+			}
+		}
+
+		first, last := len(line), 0
+		for col := range line {
+			if originX+xs[col] >= left && originX+xs[col+1] <= right {
+				if col < first {
+					first = col
+				}
+				last = col + 1
+			}
+		}
+		if first < last {
+			window.DrawScaledText(string(line[first:last]), originX+xs[first], y, scale, f.TextColor)
+		}
+
+		if f.Focused && math.Mod(f.blinkTime, 2*caretBlinkTime) < caretBlinkTime {
+			if col := f.caret - lineStart; 0 <= col && col <= len(line) {
+				x := originX + xs[col]
+				if left <= x && x <= right {
+					window.FillRect(x, y, maxInt(1, h/12), h, f.CaretColor)
+				}
+			}
+		}
+
+		lineStart += len(line) + 1
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
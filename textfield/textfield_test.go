@@ -0,0 +1,268 @@
+package textfield
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/gonutz/prototype/draw"
+)
+
+func TestTypingInsertsAtCaretAndRespectsMaxLength(t *testing.T) {
+	f := New(0, 0, 200, 20)
+	f.Focused = true
+	f.MaxLength = 5
+	w := newTestWindow()
+
+	w.frame(f, "abc")
+	w.frame(f, "", draw.KeyLeft)
+	w.frame(f, "XYZ")
+	checkText(t, f, "abXYc")
+	checkSelection(t, f, 4, 4)
+}
+
+func TestBackspaceAndDeleteRemoveSelectionOrOneCharacter(t *testing.T) {
+	f := New(0, 0, 200, 20)
+	f.Focused = true
+	f.SetText("hello")
+	w := newTestWindow()
+
+	w.frame(f, "", draw.KeyBackspace)
+	checkText(t, f, "hell")
+	w.frame(f, "", draw.KeyHome)
+	w.frame(f, "", draw.KeyDelete)
+	checkText(t, f, "ell")
+
+	w.down[draw.KeyLeftShift] = true
+	w.frame(f, "", draw.KeyRight)
+	w.frame(f, "", draw.KeyRight)
+	delete(w.down, draw.KeyLeftShift)
+	if s := f.SelectedText(); s != "el" {
+		t.Errorf("want el selected but have %q", s)
+	}
+	w.frame(f, "", draw.KeyBackspace)
+	checkText(t, f, "l")
+}
+
+func TestHeldKeysRepeat(t *testing.T) {
+	f := New(0, 0, 200, 20)
+	f.Focused = true
+	f.SetText("abcdefghij")
+	w := newTestWindow()
+
+	w.frame(f, "", draw.KeyLeft)
+	checkSelection(t, f, 9, 9)
+	w.down[draw.KeyLeft] = true
+	w.dt = repeatDelay - 0.01
+	w.frame(f, "")
+	checkSelection(t, f, 9, 9)
+	w.dt = 0.01 + 1.5*repeatInterval
+	w.frame(f, "")
+	checkSelection(t, f, 7, 7)
+}
+
+func TestMultiLineNavigation(t *testing.T) {
+	f := New(0, 0, 200, 100)
+	f.Focused = true
+	f.MultiLine = true
+	w := newTestWindow()
+
+	w.frame(f, "abcd", draw.KeyEnter)
+	w.frame(f, "ef")
+	checkText(t, f, "abcd\nef")
+	w.frame(f, "", draw.KeyUp)
+	checkSelection(t, f, 2, 2)
+	w.frame(f, "", draw.KeyEnd)
+	checkSelection(t, f, 4, 4)
+	w.frame(f, "", draw.KeyDown)
+	checkSelection(t, f, 7, 7)
+}
+
+func TestSingleLineReportsEnter(t *testing.T) {
+	f := New(0, 0, 200, 20)
+	f.Focused = true
+	w := newTestWindow()
+
+	if w.frame(f, "name") {
+		t.Error("typing should not enter the text")
+	}
+	if !w.frame(f, "", draw.KeyEnter) {
+		t.Error("Enter should enter the text")
+	}
+	checkText(t, f, "name")
+
+	f.SetText("a\nb")
+	checkText(t, f, "ab")
+}
+
+func TestCopyCutAndPaste(t *testing.T) {
+	f := New(0, 0, 200, 20)
+	f.Focused = true
+	f.SetText("hello world")
+	w := newTestWindow()
+	w.down[draw.KeyLeftControl] = true
+
+	w.frame(f, "", draw.KeyV)
+	checkText(t, f, "hello world")
+	w.frame(f, "", draw.KeyA)
+	w.frame(f, "", draw.KeyC)
+	if w.clipboard != "hello world" {
+		t.Errorf("want the text copied but the clipboard has %q", w.clipboard)
+	}
+
+	delete(w.down, draw.KeyLeftControl)
+	w.frame(f, "", draw.KeyHome)
+	w.down[draw.KeyLeftShift] = true
+	for i := 0; i < 6; i++ {
+		w.frame(f, "", draw.KeyRight)
+	}
+	delete(w.down, draw.KeyLeftShift)
+	w.down[draw.KeyLeftControl] = true
+	w.frame(f, "", draw.KeyX)
+	checkText(t, f, "world")
+	if w.clipboard != "hello " {
+		t.Errorf("want the selection cut but the clipboard has %q", w.clipboard)
+	}
+
+	w.frame(f, "", draw.KeyEnd)
+	w.frame(f, "v", draw.KeyV)
+	checkText(t, f, "worldhello ")
+}
+
+func TestAltGrTypesCharacters(t *testing.T) {
+	f := New(0, 0, 200, 20)
+	f.Focused = true
+	f.SetText("ab")
+	w := newTestWindow()
+	w.clipboard = "clipboard"
+	// Windows reports AltGr as LeftControl and RightAlt.
+	w.down[draw.KeyLeftControl] = true
+	w.down[draw.KeyRightAlt] = true
+
+	w.frame(f, "@", draw.KeyQ)
+	w.frame(f, "{", draw.Key7)
+	// Polish keyboards type ą with AltGr+A, this is not Ctrl+A.
+	w.frame(f, "ą", draw.KeyA)
+	w.frame(f, "", draw.KeyV)
+	checkText(t, f, "ab@{ą")
+	checkSelection(t, f, 5, 5)
+}
+
+func TestClickPlacesCaretAndFocuses(t *testing.T) {
+	f := New(10, 10, 200, 20)
+	f.SetText("abcdef")
+	w := newTestWindow()
+
+	// Characters are 10 pixels wide and start after the padding.
+	w.mouseDown = true
+	w.mouseX, w.mouseY = 10+padding+32, 15
+	w.clicks = []draw.MouseClick{{X: w.mouseX, Y: w.mouseY, Button: draw.LeftButton}}
+	w.frame(f, "")
+	if !f.Focused {
+		t.Fatal("clicking the field should focus it")
+	}
+	checkSelection(t, f, 3, 3)
+
+	// Dragging selects.
+	w.mouseDown = true
+	w.mouseX = 10 + padding + 11
+	w.frame(f, "")
+	checkSelection(t, f, 1, 3)
+
+	w.mouseDown = false
+	w.clicks = []draw.MouseClick{{X: 0, Y: 0, Button: draw.LeftButton}}
+	w.frame(f, "x")
+	if f.Focused {
+		t.Error("clicking outside should remove the focus")
+	}
+	checkText(t, f, "abcdef")
+}
+
+func TestTextScrollsToKeepCaretVisible(t *testing.T) {
+	f := New(0, 0, 50+2*padding, 20)
+	f.Focused = true
+	w := newTestWindow()
+
+	w.frame(f, "0123456789")
+	if f.scrollX != 50 {
+		t.Errorf("want the text scrolled by 50 but have %d", f.scrollX)
+	}
+	w.frame(f, "", draw.KeyHome)
+	if f.scrollX != 0 {
+		t.Errorf("want the text scrolled back but have %d", f.scrollX)
+	}
+
+	f.Draw(w)
+	if w.drawn != "01234" {
+		t.Errorf("only the visible text should be drawn but was %q", w.drawn)
+	}
+}
+
+func checkText(t *testing.T, f *Field, want string) {
+	t.Helper()
+	if have := f.Text(); have != want {
+		t.Errorf("want text %q but have %q", want, have)
+	}
+}
+
+func checkSelection(t *testing.T, f *Field, wantStart, wantEnd int) {
+	t.Helper()
+	if start, end := f.Selection(); start != wantStart || end != wantEnd {
+		t.Errorf("want selection %d..%d but have %d..%d", wantStart, wantEnd, start, end)
+	}
+}
+
+// testWindow is a draw.Window with monospace characters that are 10 pixels
+// wide and 16 pixels high. Only the functions used by a Field are
+// implemented.
+type testWindow struct {
+	draw.Window
+	dt             float64
+	chars          string
+	pressed        map[draw.Key]bool
+	down           map[draw.Key]bool
+	clicks         []draw.MouseClick
+	mouseDown      bool
+	mouseX, mouseY int
+	drawn          string
+	clipboard      string
+}
+
+func newTestWindow() *testWindow {
+	return &testWindow{dt: 1.0 / 60, down: make(map[draw.Key]bool)}
+}
+
+// frame updates the field with the characters typed and the keys pressed.
+func (w *testWindow) frame(f *Field, chars string, keys ...draw.Key) bool {
+	w.chars = chars
+	w.pressed = make(map[draw.Key]bool)
+	for _, k := range keys {
+		w.pressed[k] = true
+	}
+	entered := f.Update(w)
+	w.clicks = nil
+	return entered
+}
+
+func (w *testWindow) DeltaTime() float64              { return w.dt }
+func (w *testWindow) Characters() string              { return w.chars }
+func (w *testWindow) WasKeyPressed(key draw.Key) bool { return w.pressed[key] }
+func (w *testWindow) IsKeyDown(key draw.Key) bool     { return w.down[key] }
+func (w *testWindow) Clicks() []draw.MouseClick       { return w.clicks }
+func (w *testWindow) MousePosition() (int, int)       { return w.mouseX, w.mouseY }
+func (w *testWindow) ClipboardText() string           { return w.clipboard }
+func (w *testWindow) SetClipboardText(text string)    { w.clipboard = text }
+
+func (w *testWindow) IsMouseDown(button draw.MouseButton) bool {
+	return button == draw.LeftButton && w.mouseDown
+}
+
+func (w *testWindow) GetScaledTextSize(text string, scale float32) (int, int) {
+	return 10 * utf8.RuneCountInString(text), 16
+}
+
+func (w *testWindow) FillRect(x, y, width, height int, c draw.Color) {}
+func (w *testWindow) DrawRect(x, y, width, height int, c draw.Color) {}
+
+func (w *testWindow) DrawScaledText(text string, x, y int, scale float32, c draw.Color) {
+	w.drawn += text
+}
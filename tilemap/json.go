@@ -0,0 +1,340 @@
+package tilemap
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type jsonMap struct {
+	Orientation string         `json:"orientation"`
+	Infinite    bool           `json:"infinite"`
+	Width       int            `json:"width"`
+	Height      int            `json:"height"`
+	TileWidth   int            `json:"tilewidth"`
+	TileHeight  int            `json:"tileheight"`
+	Properties  []jsonProperty `json:"properties"`
+	Tilesets    []jsonTileset  `json:"tilesets"`
+	Layers      []jsonLayer    `json:"layers"`
+}
+
+type jsonProperty struct {
+	Name  string          `json:"name"`
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+type jsonTileset struct {
+	FirstGID    uint32         `json:"firstgid"`
+	Source      string         `json:"source"`
+	Name        string         `json:"name"`
+	TileWidth   int            `json:"tilewidth"`
+	TileHeight  int            `json:"tileheight"`
+	Spacing     int            `json:"spacing"`
+	Margin      int            `json:"margin"`
+	TileCount   int            `json:"tilecount"`
+	Columns     int            `json:"columns"`
+	Image       string         `json:"image"`
+	ImageWidth  int            `json:"imagewidth"`
+	ImageHeight int            `json:"imageheight"`
+	Tiles       []jsonTile     `json:"tiles"`
+	Properties  []jsonProperty `json:"properties"`
+}
+
+type jsonTile struct {
+	ID          uint32         `json:"id"`
+	Type        string         `json:"type"`
+	Class       string         `json:"class"`
+	Image       string         `json:"image"`
+	ImageWidth  int            `json:"imagewidth"`
+	ImageHeight int            `json:"imageheight"`
+	Properties  []jsonProperty `json:"properties"`
+}
+
+type jsonLayer struct {
+	Type        string          `json:"type"`
+	Name        string          `json:"name"`
+	Visible     *bool           `json:"visible"`
+	OffsetX     float64         `json:"offsetx"`
+	OffsetY     float64         `json:"offsety"`
+	Width       int             `json:"width"`
+	Height      int             `json:"height"`
+	Encoding    string          `json:"encoding"`
+	Compression string          `json:"compression"`
+	Data        json.RawMessage `json:"data"`
+	Chunks      json.RawMessage `json:"chunks"`
+	Objects     []jsonObject    `json:"objects"`
+	Layers      []jsonLayer     `json:"layers"`
+	Properties  []jsonProperty  `json:"properties"`
+}
+
+type jsonObject struct {
+	ID         int            `json:"id"`
+	Name       string         `json:"name"`
+	Type       string         `json:"type"`
+	Class      string         `json:"class"`
+	X          float64        `json:"x"`
+	Y          float64        `json:"y"`
+	Width      float64        `json:"width"`
+	Height     float64        `json:"height"`
+	Rotation   float64        `json:"rotation"`
+	GID        uint32         `json:"gid"`
+	Visible    *bool          `json:"visible"`
+	Ellipse    bool           `json:"ellipse"`
+	Point      bool           `json:"point"`
+	Polygon    []Point        `json:"polygon"`
+	Polyline   []Point        `json:"polyline"`
+	Properties []jsonProperty `json:"properties"`
+}
+
+func parseJSON(data []byte, path string) (*Map, error) {
+	var raw jsonMap
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	if raw.Orientation != "orthogonal" {
+		return nil, errors.New("only orthogonal maps are supported, this map is " + raw.Orientation)
+	}
+	if raw.Infinite {
+		return nil, errors.New("infinite maps are not supported")
+	}
+
+	m := &Map{
+		Width:      raw.Width,
+		Height:     raw.Height,
+		TileWidth:  raw.TileWidth,
+		TileHeight: raw.TileHeight,
+		Properties: jsonToProperties(raw.Properties),
+	}
+
+	for _, t := range raw.Tilesets {
+		set, err := jsonToTileset(t, path)
+		if err != nil {
+			return nil, err
+		}
+		m.Tilesets = append(m.Tilesets, set)
+	}
+
+	if err := m.addJSONLayers(raw.Layers, 0, 0, true); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func parseJSONTileset(data []byte, path string) (*Tileset, error) {
+	var t jsonTileset
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, errors.New(path + ": " + err.Error())
+	}
+	return jsonToTileset(t, path)
+}
+
+func jsonToTileset(t jsonTileset, path string) (*Tileset, error) {
+	if t.Source != "" {
+		set, err := loadTileset(resolvePath(path, t.Source))
+		if err != nil {
+			return nil, err
+		}
+		set.FirstGID = t.FirstGID
+		return set, nil
+	}
+
+	set := &Tileset{
+		FirstGID:    t.FirstGID,
+		Name:        t.Name,
+		TileWidth:   t.TileWidth,
+		TileHeight:  t.TileHeight,
+		Spacing:     t.Spacing,
+		Margin:      t.Margin,
+		TileCount:   t.TileCount,
+		Columns:     t.Columns,
+		Image:       resolvePath(path, t.Image),
+		ImageWidth:  t.ImageWidth,
+		ImageHeight: t.ImageHeight,
+		Tiles:       make(map[uint32]*TileInfo),
+		Properties:  jsonToProperties(t.Properties),
+	}
+	for _, tile := range t.Tiles {
+		typ := tile.Type
+		if typ == "" {
+			typ = tile.Class
+		}
+		set.Tiles[tile.ID] = &TileInfo{
+			ID:          tile.ID,
+			Type:        typ,
+			Image:       resolvePath(path, tile.Image),
+			ImageWidth:  tile.ImageWidth,
+			ImageHeight: tile.ImageHeight,
+			Properties:  jsonToProperties(tile.Properties),
+		}
+	}
+	return set, nil
+}
+
+func (m *Map) addJSONLayers(layers []jsonLayer, offsetX, offsetY int, visible bool) error {
+	for _, l := range layers {
+		dx := offsetX + round(l.OffsetX)
+		dy := offsetY + round(l.OffsetY)
+		vis := visible && (l.Visible == nil || *l.Visible)
+
+		switch l.Type {
+		case "tilelayer":
+			if len(l.Chunks) > 0 {
+				return errors.New("infinite maps are not supported")
+			}
+			var tiles []Tile
+			if l.Encoding == "base64" {
+				var text string
+				if err := json.Unmarshal(l.Data, &text); err != nil {
+					return errors.New("layer " + l.Name + ": " + err.Error())
+				}
+				var err error
+				tiles, err = decodeTiles(l.Encoding, l.Compression, text)
+				if err != nil {
+					return errors.New("layer " + l.Name + ": " + err.Error())
+				}
+			} else {
+				if err := json.Unmarshal(l.Data, &tiles); err != nil {
+					return errors.New("layer " + l.Name + ": " + err.Error())
+				}
+			}
+			if len(tiles) != l.Width*l.Height {
+				return errors.New("layer " + l.Name + " has " +
+					strconv.Itoa(len(tiles)) + " tiles but should have " +
+					strconv.Itoa(l.Width*l.Height))
+			}
+			m.Layers = append(m.Layers, &Layer{
+				Type:       TileLayer,
+				Name:       l.Name,
+				Visible:    vis,
+				OffsetX:    dx,
+				OffsetY:    dy,
+				Width:      l.Width,
+				Height:     l.Height,
+				Tiles:      tiles,
+				Properties: jsonToProperties(l.Properties),
+			})
+		case "objectgroup":
+			objects := make([]Object, len(l.Objects))
+			for i, o := range l.Objects {
+				typ := o.Type
+				if typ == "" {
+					typ = o.Class
+				}
+				objects[i] = Object{
+					ID:         o.ID,
+					Name:       o.Name,
+					Type:       typ,
+					X:          o.X,
+					Y:          o.Y,
+					Width:      o.Width,
+					Height:     o.Height,
+					Rotation:   o.Rotation,
+					Tile:       Tile(o.GID),
+					Visible:    o.Visible == nil || *o.Visible,
+					Ellipse:    o.Ellipse,
+					Point:      o.Point,
+					Polygon:    o.Polygon,
+					Polyline:   o.Polyline,
+					Properties: jsonToProperties(o.Properties),
+				}
+			}
+			m.Layers = append(m.Layers, &Layer{
+				Type:       ObjectLayer,
+				Name:       l.Name,
+				Visible:    vis,
+				OffsetX:    dx,
+				OffsetY:    dy,
+				Objects:    objects,
+				Properties: jsonToProperties(l.Properties),
+			})
+		case "group":
+			if err := m.addJSONLayers(l.Layers, dx, dy, vis); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func jsonToProperties(props []jsonProperty) Properties {
+	if len(props) == 0 {
+		return nil
+	}
+	p := make(Properties)
+	for _, prop := range props {
+		var s string
+		if err := json.Unmarshal(prop.Value, &s); err == nil {
+			p[prop.Name] = s
+		} else {
+			// Numbers, bools and class values are kept in their JSON form.
+			p[prop.Name] = string(prop.Value)
+		}
+	}
+	return p
+}
+
+// decodeTiles decodes tile layer data that is stored as CSV or as base64,
+// optionally compressed, little-endian uint32 values.
+func decodeTiles(encoding, compression, text string) ([]Tile, error) {
+	switch encoding {
+	case "csv":
+		var tiles []Tile
+		for _, s := range strings.Split(text, ",") {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				continue
+			}
+			gid, err := strconv.ParseUint(s, 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			tiles = append(tiles, Tile(gid))
+		}
+		return tiles, nil
+	case "base64":
+		data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(text))
+		if err != nil {
+			return nil, err
+		}
+
+		var r io.Reader
+		switch compression {
+		case "":
+			r = bytes.NewReader(data)
+		case "zlib":
+			r, err = zlib.NewReader(bytes.NewReader(data))
+		case "gzip":
+			r, err = gzip.NewReader(bytes.NewReader(data))
+		default:
+			return nil, errors.New("unsupported compression: " + compression)
+		}
+		if err != nil {
+			return nil, err
+		}
+		data, err = io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(data)%4 != 0 {
+			return nil, errors.New("tile data length is not a multiple of 4")
+		}
+		tiles := make([]Tile, len(data)/4)
+		for i := range tiles {
+			tiles[i] = Tile(binary.LittleEndian.Uint32(data[i*4:]))
+		}
+		return tiles, nil
+	default:
+		return nil, errors.New("unsupported encoding: " + encoding)
+	}
+}
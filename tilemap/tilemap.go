@@ -0,0 +1,513 @@
+// Package tilemap loads maps created with the Tiled map editor
+// (https://www.mapeditor.org) and draws them to a draw.Window.
+//
+// Maps can be saved from Tiled either in the XML based TMX format or in the
+// JSON format, Load handles both. Tilesets may be embedded in the map or be
+// stored in external TSX or JSON files. Only orthogonal, finite maps are
+// supported.
+//
+// All files are read through draw.ReadFile so you can redirect loading to your
+// own storage by setting draw.OpenFile, e.g. to load maps from an embed.FS.
+package tilemap
+
+import (
+	"errors"
+	"math"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/gonutz/prototype/draw"
+)
+
+// Map is a tile map as designed in Tiled.
+type Map struct {
+	// Width and Height are the map's size in tiles.
+	Width, Height int
+	// TileWidth and TileHeight are the size of a single map cell in pixels.
+	TileWidth, TileHeight int
+	// Tilesets are sorted by their FirstGID.
+	Tilesets []*Tileset
+	// Layers are in the order in which they are drawn, i.e. the first layer is
+	// at the bottom. Group layers are flattened, their offsets and visibility
+	// are applied to the layers that they contain.
+	Layers     []*Layer
+	Properties Properties
+}
+
+// Tileset is a collection of tiles. It is either based on a single image that
+// is cut into tiles or it is a collection of images, one per tile.
+type Tileset struct {
+	// FirstGID is the global tile ID of the first tile in this set. Tile IDs
+	// in the map layers are global, tile IDs in the tileset are local, i.e.
+	// they start at 0.
+	FirstGID uint32
+	Name     string
+	// TileWidth and TileHeight are the size of a tile in the tileset image.
+	// They may differ from the map's tile size, in which case tiles are drawn
+	// aligned to the bottom-left corner of their map cell.
+	TileWidth, TileHeight int
+	// Spacing is the number of pixels between tiles in the image and Margin is
+	// the number of pixels around the tiles, at the image border.
+	Spacing, Margin int
+	TileCount       int
+	Columns         int
+	// Image is the path to the tileset image. It is empty for image collection
+	// tilesets, in which case every Tile has its own Image.
+	Image                   string
+	ImageWidth, ImageHeight int
+	// Tiles contains extra information for the tiles that have it, e.g.
+	// custom properties. It is indexed by the local tile ID.
+	Tiles      map[uint32]*TileInfo
+	Properties Properties
+}
+
+// TileInfo holds optional information for a single tile in a Tileset.
+type TileInfo struct {
+	// ID is the local tile ID inside the Tileset.
+	ID   uint32
+	Type string
+	// Image is only set for tiles in image collection tilesets.
+	Image                   string
+	ImageWidth, ImageHeight int
+	Properties              Properties
+}
+
+// LayerType tells the kind of data that a Layer contains.
+type LayerType int
+
+const (
+	// TileLayer has its Tiles set.
+	TileLayer LayerType = iota
+	// ObjectLayer has its Objects set.
+	ObjectLayer
+)
+
+// Layer is either a grid of tiles or a list of objects, see Type.
+type Layer struct {
+	Type    LayerType
+	Name    string
+	Visible bool
+	// OffsetX and OffsetY are the layer's drawing offset in pixels.
+	OffsetX, OffsetY int
+	// Width and Height are the size of a tile layer, in tiles.
+	Width, Height int
+	// Tiles are stored row by row, starting at the top-left. Use TileAt to
+	// access them by cell position.
+	Tiles []Tile
+	// Objects are only set for object layers.
+	Objects    []Object
+	Properties Properties
+}
+
+// TileAt returns the tile in cell x,y or an empty tile if the cell is outside
+// the layer.
+func (l *Layer) TileAt(x, y int) Tile {
+	if x < 0 || y < 0 || x >= l.Width || y >= l.Height {
+		return 0
+	}
+	return l.Tiles[x+y*l.Width]
+}
+
+// Tile is a global tile ID which also contains the tile's flip flags in the
+// upper bits. Tile 0 is an empty cell.
+type Tile uint32
+
+const (
+	flippedHorizontally = 0x80000000
+	flippedVertically   = 0x40000000
+	flippedDiagonally   = 0x20000000
+	// rotatedHexagonal120 is only used for hexagonal maps but we still need
+	// to mask it out of the ID.
+	rotatedHexagonal120 = 0x10000000
+	flipMask            = flippedHorizontally | flippedVertically |
+		flippedDiagonally | rotatedHexagonal120
+)
+
+// GID returns the global tile ID without the flip flags.
+func (t Tile) GID() uint32 {
+	return uint32(t) &^ flipMask
+}
+
+// IsEmpty reports whether there is no tile in a cell.
+func (t Tile) IsEmpty() bool {
+	return t.GID() == 0
+}
+
+// FlippedHorizontally reports whether the tile is mirrored left to right.
+func (t Tile) FlippedHorizontally() bool {
+	return t&flippedHorizontally != 0
+}
+
+// FlippedVertically reports whether the tile is mirrored top to bottom.
+func (t Tile) FlippedVertically() bool {
+	return t&flippedVertically != 0
+}
+
+// FlippedDiagonally reports whether the tile is mirrored along its top-left to
+// bottom-right diagonal. Tiled combines this with the other flip flags to
+// express rotations by 90 degrees.
+func (t Tile) FlippedDiagonally() bool {
+	return t&flippedDiagonally != 0
+}
+
+// Object is an element of an object layer. Its position and size are in
+// pixels. Depending on its shape, Ellipse or Point is true, or Polygon or
+// Polyline is set. Objects that are tiles have their Tile set.
+type Object struct {
+	ID                  int
+	Name                string
+	Type                string
+	X, Y, Width, Height float64
+	// Rotation is given in degrees clockwise, around X,Y.
+	Rotation float64
+	// Tile is non-empty for tile objects. Note that Tiled positions tile
+	// objects at their bottom-left corner, while all other objects are
+	// positioned at their top-left corner.
+	Tile     Tile
+	Visible  bool
+	Ellipse  bool
+	Point    bool
+	Polygon  []Point
+	Polyline []Point
+	// Properties has the object's own properties. If the object is a tile,
+	// the tile's properties are not copied here, look them up with
+	// Map.TileInfo instead.
+	Properties Properties
+}
+
+// Point is a polygon or polyline vertex, relative to the object's position.
+type Point struct {
+	X, Y float64
+}
+
+// Properties are the custom properties that Tiled lets you attach to maps,
+// tilesets, tiles, layers and objects. Values are stored as they appear in
+// the file, use the typed getters to convert them.
+type Properties map[string]string
+
+// Int returns the named property as an integer or 0 if it does not exist or
+// is not a number.
+func (p Properties) Int(name string) int {
+	i, _ := strconv.Atoi(p[name])
+	return i
+}
+
+// Float returns the named property as a number or 0 if it does not exist or
+// is not a number.
+func (p Properties) Float(name string) float64 {
+	f, _ := strconv.ParseFloat(p[name], 64)
+	return f
+}
+
+// Bool returns true if the named property exists and is "true".
+func (p Properties) Bool(name string) bool {
+	return p[name] == "true"
+}
+
+// Load reads a TMX or JSON map file and all external tilesets that it
+// references. Image paths in the map are made relative to the map file, so
+// they can be passed to the draw.Window directly.
+func Load(path string) (*Map, error) {
+	data, err := draw.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var m *Map
+	if isXML(data) {
+		m, err = parseTMX(data, path)
+	} else {
+		m, err = parseJSON(data, path)
+	}
+	if err != nil {
+		return nil, errors.New("tilemap: " + path + ": " + err.Error())
+	}
+
+	sort.Slice(m.Tilesets, func(i, j int) bool {
+		return m.Tilesets[i].FirstGID < m.Tilesets[j].FirstGID
+	})
+	return m, nil
+}
+
+func isXML(data []byte) bool {
+	for _, b := range data {
+		switch b {
+		case ' ', '\t', '\r', '\n', 0xEF, 0xBB, 0xBF: // Skip space and BOM.
+		case '<':
+			return true
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// resolvePath makes file, which is relative to the file at base, relative to
+// the working directory. It uses '/' as the separator on all systems so the
+// result can be opened from an embed.FS as well.
+func resolvePath(base, file string) string {
+	if file == "" || path.IsAbs(file) || filepath.IsAbs(file) {
+		return file
+	}
+	return path.Join(path.Dir(filepath.ToSlash(base)), file)
+}
+
+// Layer returns the first layer with the given name or nil if there is none.
+func (m *Map) Layer(name string) *Layer {
+	for _, l := range m.Layers {
+		if l.Name == name {
+			return l
+		}
+	}
+	return nil
+}
+
+// Tileset returns the tileset that contains the given tile or nil if the tile
+// is empty or its ID is not part of any tileset.
+func (m *Map) Tileset(t Tile) *Tileset {
+	gid := t.GID()
+	if gid == 0 {
+		return nil
+	}
+	for i := len(m.Tilesets) - 1; i >= 0; i-- {
+		if m.Tilesets[i].FirstGID <= gid {
+			return m.Tilesets[i]
+		}
+	}
+	return nil
+}
+
+// TileInfo returns the extra information that the tileset stores for the
+// given tile, e.g. its custom properties. It returns nil if there is none.
+func (m *Map) TileInfo(t Tile) *TileInfo {
+	set := m.Tileset(t)
+	if set == nil {
+		return nil
+	}
+	return set.Tiles[t.GID()-set.FirstGID]
+}
+
+// Draw draws all visible layers of the map. cameraX and cameraY are the map
+// pixel coordinates that appear at the window's top-left corner. Only tiles
+// that overlap the window are drawn.
+// If a tileset image cannot be drawn, the first such error is returned but
+// drawing continues with the other tiles.
+func (m *Map) Draw(window draw.Window, cameraX, cameraY int) error {
+	var firstErr error
+	for i := range m.Layers {
+		if !m.Layers[i].Visible {
+			continue
+		}
+		if err := m.DrawLayer(window, i, cameraX, cameraY); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// DrawLayer draws the layer at the given index, regardless of its visibility.
+// See Draw for the camera coordinates.
+func (m *Map) DrawLayer(window draw.Window, index, cameraX, cameraY int) error {
+	l := m.Layers[index]
+	if l.Type == ObjectLayer {
+		return m.drawObjects(window, l, cameraX, cameraY)
+	}
+
+	windowW, windowH := window.Size()
+	left := cameraX - l.OffsetX
+	top := cameraY - l.OffsetY
+
+	// Tiles that are larger than the map cells extend to the top and right,
+	// we have to look at more cells to find all that overlap the window.
+	extraW, extraH := 0, 0
+	for _, set := range m.Tilesets {
+		extraW = maxInt(extraW, set.maxTileWidth()-m.TileWidth)
+		extraH = maxInt(extraH, set.maxTileHeight()-m.TileHeight)
+	}
+
+	startX := maxInt(0, floorDiv(left-extraW, m.TileWidth))
+	endX := minInt(l.Width-1, floorDiv(left+windowW, m.TileWidth))
+	startY := maxInt(0, floorDiv(top, m.TileHeight))
+	endY := minInt(l.Height-1, floorDiv(top+windowH+extraH, m.TileHeight))
+
+	var firstErr error
+	for y := startY; y <= endY; y++ {
+		for x := startX; x <= endX; x++ {
+			tile := l.Tiles[x+y*l.Width]
+			if tile.IsEmpty() {
+				continue
+			}
+			cellX := x*m.TileWidth - left
+			cellBottom := (y+1)*m.TileHeight - top
+			err := m.drawTile(window, tile, cellX, cellBottom, -1, -1, 0)
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+func (m *Map) drawObjects(window draw.Window, l *Layer, cameraX, cameraY int) error {
+	windowW, windowH := window.Size()
+	var firstErr error
+	for i := range l.Objects {
+		o := &l.Objects[i]
+		if !o.Visible || o.Tile.IsEmpty() {
+			continue
+		}
+		x := round(o.X) + l.OffsetX - cameraX
+		y := round(o.Y) + l.OffsetY - cameraY
+		w, h := round(o.Width), round(o.Height)
+		// Rotated objects can reach at most this far from their origin.
+		reach := w + h
+		if x+reach < 0 || y+reach < 0 || x-reach >= windowW || y-reach >= windowH {
+			continue
+		}
+		err := m.drawTile(window, o.Tile, x, y, w, h, o.Rotation)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// drawTile draws the tile with its bottom-left corner at x,y. If width and
+// height are -1, the tile's size in the tileset is used. The tile is rotated
+// clockwise about its bottom-left corner.
+func (m *Map) drawTile(
+	window draw.Window,
+	tile Tile,
+	x, y, width, height int,
+	rotationCWDeg float64,
+) error {
+	set := m.Tileset(tile)
+	if set == nil {
+		return nil
+	}
+	id := tile.GID() - set.FirstGID
+
+	path := set.Image
+	srcW, srcH := set.TileWidth, set.TileHeight
+	srcX, srcY := 0, 0
+	if path == "" {
+		info := set.Tiles[id]
+		if info == nil || info.Image == "" {
+			return nil
+		}
+		path = info.Image
+		srcW, srcH = info.ImageWidth, info.ImageHeight
+		if srcW == 0 || srcH == 0 {
+			var err error
+			srcW, srcH, err = window.ImageSize(path)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		columns := set.Columns
+		if columns <= 0 {
+			columns = 1
+		}
+		col, row := int(id)%columns, int(id)/columns
+		srcX = set.Margin + col*(set.TileWidth+set.Spacing)
+		srcY = set.Margin + row*(set.TileHeight+set.Spacing)
+	}
+
+	if width == -1 && height == -1 {
+		width, height = srcW, srcH
+	}
+
+	// Tiled rotates objects around their bottom-left corner, the draw.Window
+	// rotates images around their center, so we move the center accordingly.
+	sin, cos := math.Sincos(rotationCWDeg * math.Pi / 180)
+	halfW, halfH := float64(width)/2, -float64(height)/2
+	centerX := float64(x) + cos*halfW - sin*halfH
+	centerY := float64(y) + sin*halfW + cos*halfH
+
+	flipX, flipY, degrees := flipTransform(tile)
+	destW, destH := width, height
+	if flipX {
+		destW = -destW
+	}
+	if flipY {
+		destH = -destH
+	}
+
+	return window.DrawImageFilePart(
+		path,
+		srcX, srcY, srcW, srcH,
+		round(centerX-float64(destW)/2), round(centerY-float64(destH)/2),
+		destW, destH,
+		degrees+round(rotationCWDeg),
+	)
+}
+
+// flipTransform translates Tiled's flip flags to the mirroring and rotation
+// that the draw.Window supports. The image is first mirrored, then rotated
+// clockwise about its center.
+func flipTransform(t Tile) (flipX, flipY bool, rotationCWDeg int) {
+	h, v, d := t.FlippedHorizontally(), t.FlippedVertically(), t.FlippedDiagonally()
+	if !d {
+		return h, v, 0
+	}
+	// A diagonal flip swaps the x and y axes. Tiled applies it before the
+	// horizontal and vertical flips.
+	switch {
+	case h && v:
+		return false, true, 270
+	case h:
+		return false, false, 90
+	case v:
+		return false, false, 270
+	default:
+		return false, true, 90
+	}
+}
+
+func (set *Tileset) maxTileWidth() int {
+	w := set.TileWidth
+	for _, t := range set.Tiles {
+		w = maxInt(w, t.ImageWidth)
+	}
+	return w
+}
+
+func (set *Tileset) maxTileHeight() int {
+	h := set.TileHeight
+	for _, t := range set.Tiles {
+		h = maxInt(h, t.ImageHeight)
+	}
+	return h
+}
+
+func floorDiv(a, b int) int {
+	if b <= 0 {
+		return 0
+	}
+	q := a / b
+	if a%b != 0 && a < 0 {
+		q--
+	}
+	return q
+}
+
+func round(x float64) int {
+	return int(math.Floor(x + 0.5))
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,260 @@
+package tilemap
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gonutz/prototype/draw"
+)
+
+func TestLoadTMXWithExternalTileset(t *testing.T) {
+	useFiles(t, map[string]string{
+		"maps/level.tmx": `<?xml version="1.0" encoding="UTF-8"?>
+<map version="1.10" orientation="orthogonal" renderorder="right-down" width="3" height="2" tilewidth="16" tileheight="16" infinite="0">
+ <properties>
+  <property name="music" value="cave.ogg"/>
+  <property name="gravity" type="float" value="9.5"/>
+ </properties>
+ <tileset firstgid="1" source="../tiles/ground.tsx"/>
+ <layer id="1" name="ground" width="3" height="2">
+  <data encoding="csv">
+1,2,0,
+3,2147483649,4
+</data>
+ </layer>
+ <group id="3" name="things" offsetx="5" offsety="6" visible="0">
+  <objectgroup id="2" name="objects" offsetx="1">
+   <object id="1" name="start" type="spawn" x="8" y="24" width="16" height="16">
+    <properties>
+     <property name="lives" type="int" value="3"/>
+    </properties>
+   </object>
+   <object id="2" gid="2" x="32" y="32" width="16" height="16"/>
+   <object id="3" x="0" y="0">
+    <polygon points="0,0 10,0 5,-8.5"/>
+   </object>
+  </objectgroup>
+ </group>
+</map>`,
+		"tiles/ground.tsx": `<?xml version="1.0" encoding="UTF-8"?>
+<tileset version="1.10" name="ground" tilewidth="16" tileheight="16" spacing="1" margin="2" tilecount="4" columns="2">
+ <image source="ground.png" width="37" height="37"/>
+ <tile id="1" type="wall">
+  <properties>
+   <property name="solid" type="bool" value="true"/>
+  </properties>
+ </tile>
+</tileset>`,
+	})
+
+	m, err := Load("maps/level.tmx")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Width != 3 || m.Height != 2 || m.TileWidth != 16 || m.TileHeight != 16 {
+		t.Errorf("wrong map size: %+v", m)
+	}
+	if m.Properties["music"] != "cave.ogg" || m.Properties.Float("gravity") != 9.5 {
+		t.Errorf("wrong map properties: %v", m.Properties)
+	}
+
+	if len(m.Tilesets) != 1 {
+		t.Fatalf("want 1 tileset but have %d", len(m.Tilesets))
+	}
+	set := m.Tilesets[0]
+	if set.FirstGID != 1 || set.Columns != 2 || set.Spacing != 1 || set.Margin != 2 {
+		t.Errorf("wrong tileset: %+v", set)
+	}
+	if set.Image != "tiles/ground.png" {
+		t.Errorf("wrong tileset image path: %q", set.Image)
+	}
+
+	if len(m.Layers) != 2 {
+		t.Fatalf("want 2 layers but have %d", len(m.Layers))
+	}
+
+	ground := m.Layer("ground")
+	if ground == nil || ground.Type != TileLayer || !ground.Visible {
+		t.Fatalf("wrong ground layer: %+v", ground)
+	}
+	checkTiles(t, ground.Tiles, 1, 2, 0, 3, 0x80000001, 4)
+	if !ground.TileAt(1, 1).FlippedHorizontally() || ground.TileAt(1, 1).GID() != 1 {
+		t.Errorf("tile 1,1 should be tile 1 flipped horizontally")
+	}
+	if !ground.TileAt(5, 5).IsEmpty() {
+		t.Errorf("tiles outside the layer should be empty")
+	}
+
+	wall := m.TileInfo(ground.TileAt(1, 0))
+	if wall == nil || wall.Type != "wall" || !wall.Properties.Bool("solid") {
+		t.Errorf("wrong tile info: %+v", wall)
+	}
+
+	objects := m.Layer("objects")
+	if objects == nil || objects.Type != ObjectLayer {
+		t.Fatalf("wrong object layer: %+v", objects)
+	}
+	if objects.Visible {
+		t.Errorf("object layer should be hidden because its group is hidden")
+	}
+	if objects.OffsetX != 6 || objects.OffsetY != 6 {
+		t.Errorf("want object layer offset 6,6 but have %d,%d", objects.OffsetX, objects.OffsetY)
+	}
+	if len(objects.Objects) != 3 {
+		t.Fatalf("want 3 objects but have %d", len(objects.Objects))
+	}
+	start := objects.Objects[0]
+	if start.Name != "start" || start.Type != "spawn" || start.X != 8 ||
+		start.Y != 24 || start.Properties.Int("lives") != 3 {
+		t.Errorf("wrong start object: %+v", start)
+	}
+	if objects.Objects[1].Tile.GID() != 2 {
+		t.Errorf("object 2 should be tile 2")
+	}
+	poly := objects.Objects[2].Polygon
+	if len(poly) != 3 || poly[2] != (Point{5, -8.5}) {
+		t.Errorf("wrong polygon: %v", poly)
+	}
+}
+
+func TestLoadJSONWithCompressedLayer(t *testing.T) {
+	var buf bytes.Buffer
+	z := zlib.NewWriter(&buf)
+	z.Write([]byte{
+		1, 0, 0, 0,
+		0, 0, 0, 0,
+		2, 0, 0, 0x40,
+		3, 0, 0, 0,
+	})
+	z.Close()
+	data := base64.StdEncoding.EncodeToString(buf.Bytes())
+
+	useFiles(t, map[string]string{
+		"level.json": `{
+ "orientation": "orthogonal",
+ "width": 2, "height": 2, "tilewidth": 8, "tileheight": 8,
+ "tilesets": [
+  {"firstgid": 3, "name": "b", "tilewidth": 8, "tileheight": 8,
+   "image": "b.png", "imagewidth": 16, "imageheight": 8,
+   "tilecount": 2, "columns": 2},
+  {"firstgid": 1, "name": "a", "tilewidth": 8, "tileheight": 8,
+   "image": "a.png", "imagewidth": 16, "imageheight": 8,
+   "tilecount": 2, "columns": 2,
+   "properties": [{"name": "speed", "type": "int", "value": 4}]}
+ ],
+ "layers": [
+  {"type": "tilelayer", "name": "z", "width": 2, "height": 2,
+   "encoding": "base64", "compression": "zlib", "data": "` + data + `"},
+  {"type": "tilelayer", "name": "csv", "width": 2, "height": 1,
+   "visible": false, "data": [4, 0]},
+  {"type": "objectgroup", "name": "o", "objects": [
+   {"id": 7, "name": "door", "x": 1.5, "y": 2, "point": true,
+    "properties": [{"name": "target", "type": "string", "value": "hall"}]}
+  ]}
+ ]
+}`,
+	})
+
+	m, err := Load("level.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(m.Tilesets) != 2 || m.Tilesets[0].Name != "a" || m.Tilesets[1].Name != "b" {
+		t.Fatalf("tilesets should be sorted by first GID")
+	}
+	if m.Tilesets[0].Properties.Int("speed") != 4 {
+		t.Errorf("wrong tileset properties: %v", m.Tilesets[0].Properties)
+	}
+
+	z1 := m.Layers[0]
+	checkTiles(t, z1.Tiles, 1, 0, 0x40000002, 3)
+	if m.Tileset(z1.Tiles[3]).Name != "b" || m.Tileset(z1.Tiles[2]).Name != "a" {
+		t.Errorf("tiles are associated with the wrong tilesets")
+	}
+
+	csv := m.Layers[1]
+	checkTiles(t, csv.Tiles, 4, 0)
+	if csv.Visible {
+		t.Errorf("csv layer should be hidden")
+	}
+
+	door := m.Layers[2].Objects[0]
+	if door.ID != 7 || door.X != 1.5 || !door.Point || !door.Visible ||
+		door.Properties["target"] != "hall" {
+		t.Errorf("wrong door object: %+v", door)
+	}
+}
+
+func TestUnsupportedMapsFailToLoad(t *testing.T) {
+	useFiles(t, map[string]string{
+		"iso.tmx": `<map orientation="isometric" width="1" height="1"
+			tilewidth="8" tileheight="8"></map>`,
+		"infinite.json": `{"orientation": "orthogonal", "infinite": true}`,
+		"wrong_size.json": `{"orientation": "orthogonal", "width": 2,
+			"height": 2, "layers": [{"type": "tilelayer", "width": 2,
+			"height": 2, "data": [1, 2, 3]}]}`,
+	})
+
+	for _, path := range []string{"iso.tmx", "infinite.json", "wrong_size.json"} {
+		if _, err := Load(path); err == nil {
+			t.Errorf("%s: expected an error", path)
+		}
+	}
+}
+
+func TestFlipFlagsTranslateToMirrorAndRotation(t *testing.T) {
+	const h, v, d = flippedHorizontally, flippedVertically, flippedDiagonally
+	flip(t, 0, false, false, 0)
+	flip(t, h, true, false, 0)
+	flip(t, v, false, true, 0)
+	flip(t, h|v, true, true, 0)
+	flip(t, d, false, true, 90)
+	flip(t, d|h, false, false, 90)
+	flip(t, d|v, false, false, 270)
+	flip(t, d|h|v, false, true, 270)
+}
+
+func flip(t *testing.T, flags uint32, flipX, flipY bool, degrees int) {
+	t.Helper()
+	x, y, deg := flipTransform(Tile(flags | 1))
+	if x != flipX || y != flipY || deg != degrees {
+		t.Errorf("flags %X: want %v %v %d but have %v %v %d",
+			flags, flipX, flipY, degrees, x, y, deg)
+	}
+}
+
+func checkTiles(t *testing.T, have []Tile, want ...Tile) {
+	t.Helper()
+	if len(have) != len(want) {
+		t.Errorf("want tiles %v but have %v", want, have)
+		return
+	}
+	for i := range want {
+		if have[i] != want[i] {
+			t.Errorf("want tiles %v but have %v", want, have)
+			return
+		}
+	}
+}
+
+// useFiles makes the draw package load the given files instead of reading
+// from disk for the rest of the test.
+func useFiles(t *testing.T, files map[string]string) {
+	oldOpen := draw.OpenFile
+	t.Cleanup(func() { draw.OpenFile = oldOpen })
+
+	draw.OpenFile = func(path string) (io.ReadCloser, error) {
+		content, ok := files[path]
+		if !ok {
+			return nil, errors.New("file not found: " + path)
+		}
+		return ioutil.NopCloser(bytes.NewReader([]byte(content))), nil
+	}
+}
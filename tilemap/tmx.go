@@ -0,0 +1,323 @@
+package tilemap
+
+import (
+	"encoding/xml"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gonutz/prototype/draw"
+)
+
+type tmxMap struct {
+	Orientation string          `xml:"orientation,attr"`
+	Infinite    int             `xml:"infinite,attr"`
+	Width       int             `xml:"width,attr"`
+	Height      int             `xml:"height,attr"`
+	TileWidth   int             `xml:"tilewidth,attr"`
+	TileHeight  int             `xml:"tileheight,attr"`
+	Properties  []tmxProperty   `xml:"properties>property"`
+	Tilesets    []tmxTileset    `xml:"tileset"`
+	Layers      []tmxLayerGroup `xml:",any"`
+}
+
+type tmxProperty struct {
+	Name  string  `xml:"name,attr"`
+	Type  string  `xml:"type,attr"`
+	Value *string `xml:"value,attr"`
+	// Multi-line strings are stored as the element text instead of the value
+	// attribute.
+	Text string `xml:",chardata"`
+}
+
+type tmxTileset struct {
+	FirstGID   uint32        `xml:"firstgid,attr"`
+	Source     string        `xml:"source,attr"`
+	Name       string        `xml:"name,attr"`
+	TileWidth  int           `xml:"tilewidth,attr"`
+	TileHeight int           `xml:"tileheight,attr"`
+	Spacing    int           `xml:"spacing,attr"`
+	Margin     int           `xml:"margin,attr"`
+	TileCount  int           `xml:"tilecount,attr"`
+	Columns    int           `xml:"columns,attr"`
+	Image      tmxImage      `xml:"image"`
+	Tiles      []tmxTile     `xml:"tile"`
+	Properties []tmxProperty `xml:"properties>property"`
+}
+
+type tmxImage struct {
+	Source string `xml:"source,attr"`
+	Width  int    `xml:"width,attr"`
+	Height int    `xml:"height,attr"`
+}
+
+type tmxTile struct {
+	ID         uint32        `xml:"id,attr"`
+	Type       string        `xml:"type,attr"`
+	Class      string        `xml:"class,attr"`
+	Image      tmxImage      `xml:"image"`
+	Properties []tmxProperty `xml:"properties>property"`
+}
+
+// tmxLayerGroup is any of the layer elements: layer, objectgroup, group or
+// imagelayer. We decode them all into this one type to keep their order.
+type tmxLayerGroup struct {
+	XMLName    xml.Name
+	Name       string          `xml:"name,attr"`
+	Visible    string          `xml:"visible,attr"`
+	OffsetX    float64         `xml:"offsetx,attr"`
+	OffsetY    float64         `xml:"offsety,attr"`
+	Width      int             `xml:"width,attr"`
+	Height     int             `xml:"height,attr"`
+	Properties []tmxProperty   `xml:"properties>property"`
+	Data       tmxData         `xml:"data"`
+	Objects    []tmxObject     `xml:"object"`
+	Layers     []tmxLayerGroup `xml:",any"`
+}
+
+type tmxData struct {
+	Encoding    string `xml:"encoding,attr"`
+	Compression string `xml:"compression,attr"`
+	Text        string `xml:",chardata"`
+	Tiles       []struct {
+		GID uint32 `xml:"gid,attr"`
+	} `xml:"tile"`
+	Chunks []struct{} `xml:"chunk"`
+}
+
+type tmxObject struct {
+	ID         int           `xml:"id,attr"`
+	Name       string        `xml:"name,attr"`
+	Type       string        `xml:"type,attr"`
+	Class      string        `xml:"class,attr"`
+	X          float64       `xml:"x,attr"`
+	Y          float64       `xml:"y,attr"`
+	Width      float64       `xml:"width,attr"`
+	Height     float64       `xml:"height,attr"`
+	Rotation   float64       `xml:"rotation,attr"`
+	GID        uint32        `xml:"gid,attr"`
+	Visible    string        `xml:"visible,attr"`
+	Ellipse    *struct{}     `xml:"ellipse"`
+	Point      *struct{}     `xml:"point"`
+	Polygon    *tmxPoints    `xml:"polygon"`
+	Polyline   *tmxPoints    `xml:"polyline"`
+	Properties []tmxProperty `xml:"properties>property"`
+}
+
+type tmxPoints struct {
+	Points string `xml:"points,attr"`
+}
+
+func parseTMX(data []byte, path string) (*Map, error) {
+	var raw tmxMap
+	if err := xml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	if raw.Orientation != "orthogonal" {
+		return nil, errors.New("only orthogonal maps are supported, this map is " + raw.Orientation)
+	}
+	if raw.Infinite != 0 {
+		return nil, errors.New("infinite maps are not supported")
+	}
+
+	m := &Map{
+		Width:      raw.Width,
+		Height:     raw.Height,
+		TileWidth:  raw.TileWidth,
+		TileHeight: raw.TileHeight,
+		Properties: tmxToProperties(raw.Properties),
+	}
+
+	for _, t := range raw.Tilesets {
+		set, err := tmxToTileset(t, path)
+		if err != nil {
+			return nil, err
+		}
+		m.Tilesets = append(m.Tilesets, set)
+	}
+
+	err := m.addTMXLayers(raw.Layers, 0, 0, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func tmxToTileset(t tmxTileset, path string) (*Tileset, error) {
+	if t.Source != "" {
+		setPath := resolvePath(path, t.Source)
+		set, err := loadTileset(setPath)
+		if err != nil {
+			return nil, err
+		}
+		set.FirstGID = t.FirstGID
+		return set, nil
+	}
+
+	set := &Tileset{
+		FirstGID:    t.FirstGID,
+		Name:        t.Name,
+		TileWidth:   t.TileWidth,
+		TileHeight:  t.TileHeight,
+		Spacing:     t.Spacing,
+		Margin:      t.Margin,
+		TileCount:   t.TileCount,
+		Columns:     t.Columns,
+		Image:       resolvePath(path, t.Image.Source),
+		ImageWidth:  t.Image.Width,
+		ImageHeight: t.Image.Height,
+		Tiles:       make(map[uint32]*TileInfo),
+		Properties:  tmxToProperties(t.Properties),
+	}
+	for _, tile := range t.Tiles {
+		typ := tile.Type
+		if typ == "" {
+			typ = tile.Class
+		}
+		set.Tiles[tile.ID] = &TileInfo{
+			ID:          tile.ID,
+			Type:        typ,
+			Image:       resolvePath(path, tile.Image.Source),
+			ImageWidth:  tile.Image.Width,
+			ImageHeight: tile.Image.Height,
+			Properties:  tmxToProperties(tile.Properties),
+		}
+	}
+	return set, nil
+}
+
+// loadTileset reads an external tileset file in TSX or JSON format.
+func loadTileset(path string) (*Tileset, error) {
+	data, err := draw.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if isXML(data) {
+		var t tmxTileset
+		if err := xml.Unmarshal(data, &t); err != nil {
+			return nil, errors.New(path + ": " + err.Error())
+		}
+		return tmxToTileset(t, path)
+	}
+
+	return parseJSONTileset(data, path)
+}
+
+func (m *Map) addTMXLayers(layers []tmxLayerGroup, offsetX, offsetY int, visible bool) error {
+	for _, l := range layers {
+		dx := offsetX + round(l.OffsetX)
+		dy := offsetY + round(l.OffsetY)
+		vis := visible && l.Visible != "0"
+
+		switch l.XMLName.Local {
+		case "layer":
+			if len(l.Data.Chunks) > 0 {
+				return errors.New("infinite maps are not supported")
+			}
+			var tiles []Tile
+			if l.Data.Encoding == "" {
+				for _, t := range l.Data.Tiles {
+					tiles = append(tiles, Tile(t.GID))
+				}
+			} else {
+				var err error
+				tiles, err = decodeTiles(l.Data.Encoding, l.Data.Compression, l.Data.Text)
+				if err != nil {
+					return errors.New("layer " + l.Name + ": " + err.Error())
+				}
+			}
+			if len(tiles) != l.Width*l.Height {
+				return errors.New("layer " + l.Name + " has " +
+					strconv.Itoa(len(tiles)) + " tiles but should have " +
+					strconv.Itoa(l.Width*l.Height))
+			}
+			m.Layers = append(m.Layers, &Layer{
+				Type:       TileLayer,
+				Name:       l.Name,
+				Visible:    vis,
+				OffsetX:    dx,
+				OffsetY:    dy,
+				Width:      l.Width,
+				Height:     l.Height,
+				Tiles:      tiles,
+				Properties: tmxToProperties(l.Properties),
+			})
+		case "objectgroup":
+			objects := make([]Object, len(l.Objects))
+			for i, o := range l.Objects {
+				typ := o.Type
+				if typ == "" {
+					typ = o.Class
+				}
+				objects[i] = Object{
+					ID:         o.ID,
+					Name:       o.Name,
+					Type:       typ,
+					X:          o.X,
+					Y:          o.Y,
+					Width:      o.Width,
+					Height:     o.Height,
+					Rotation:   o.Rotation,
+					Tile:       Tile(o.GID),
+					Visible:    o.Visible != "0",
+					Ellipse:    o.Ellipse != nil,
+					Point:      o.Point != nil,
+					Properties: tmxToProperties(o.Properties),
+				}
+				if o.Polygon != nil {
+					objects[i].Polygon = parsePoints(o.Polygon.Points)
+				}
+				if o.Polyline != nil {
+					objects[i].Polyline = parsePoints(o.Polyline.Points)
+				}
+			}
+			m.Layers = append(m.Layers, &Layer{
+				Type:       ObjectLayer,
+				Name:       l.Name,
+				Visible:    vis,
+				OffsetX:    dx,
+				OffsetY:    dy,
+				Objects:    objects,
+				Properties: tmxToProperties(l.Properties),
+			})
+		case "group":
+			if err := m.addTMXLayers(l.Layers, dx, dy, vis); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parsePoints parses Tiled's point lists of the form "0,0 10,5 3,-2".
+func parsePoints(s string) []Point {
+	var points []Point
+	for _, pair := range strings.Fields(s) {
+		xy := strings.Split(pair, ",")
+		if len(xy) != 2 {
+			continue
+		}
+		x, _ := strconv.ParseFloat(xy[0], 64)
+		y, _ := strconv.ParseFloat(xy[1], 64)
+		points = append(points, Point{X: x, Y: y})
+	}
+	return points
+}
+
+func tmxToProperties(props []tmxProperty) Properties {
+	if len(props) == 0 {
+		return nil
+	}
+	p := make(Properties)
+	for _, prop := range props {
+		if prop.Value != nil {
+			p[prop.Name] = *prop.Value
+		} else {
+			p[prop.Name] = prop.Text
+		}
+	}
+	return p
+}